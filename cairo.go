@@ -1,10 +1,13 @@
 package cairo
 
 import (
+	"image"
+	"io"
 	"unsafe"
 
 	"github.com/mikowitz/cairo/context"
 	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/matrix"
 	"github.com/mikowitz/cairo/pattern"
 	"github.com/mikowitz/cairo/surface"
 )
@@ -65,6 +68,89 @@ func NewImageSurface(format Format, width, height int) (*surface.ImageSurface, e
 	return surf, nil
 }
 
+// NewImageSurfaceForData creates an image surface that renders into the
+// caller-owned buffer data, which must hold at least stride*height bytes in
+// the given format. Use Format.StrideForWidth to compute a valid stride.
+//
+// The buffer stays pinned for as long as Cairo uses the surface. See
+// surface.NewImageSurfaceForData for details.
+func NewImageSurfaceForData(data []byte, format Format, width, height, stride int) (*surface.ImageSurface, error) {
+	surf, err := surface.NewImageSurfaceForData(data, format, width, height, stride)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "image")
+	}
+	return surf, nil
+}
+
+// NewImageSurfaceFromImage creates an ARGB32 image surface containing a copy
+// of img, for example an image decoded with the standard library, so it can be
+// drawn over or used as a pattern source.
+func NewImageSurfaceFromImage(img image.Image) (*surface.ImageSurface, error) {
+	surf, err := surface.NewImageSurfaceFromImage(img)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "image")
+	}
+	return surf, nil
+}
+
+// NewImageSurfaceFromPNG creates an image surface holding the contents of the
+// PNG file at filename, with its format and size taken from the image.
+func NewImageSurfaceFromPNG(filename string) (*surface.ImageSurface, error) {
+	surf, err := surface.NewImageSurfaceFromPNG(filename)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "image")
+	}
+	return surf, nil
+}
+
+// NewImageSurfaceFromPNGReader creates an image surface from PNG data read
+// from r, such as an embedded asset or an HTTP response body.
+func NewImageSurfaceFromPNGReader(r io.Reader) (*surface.ImageSurface, error) {
+	surf, err := surface.NewImageSurfaceFromPNGReader(r)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "image")
+	}
+	return surf, nil
+}
+
+// Content describes whether a surface holds color, alpha, or both.
+type Content = surface.Content
+
+// Content constants specify what a surface holds.
+const (
+	// ContentColor means the surface holds color information only.
+	ContentColor = surface.ContentColor
+	// ContentAlpha means the surface holds alpha (transparency) information only.
+	ContentAlpha = surface.ContentAlpha
+	// ContentColorAlpha means the surface holds both color and alpha information.
+	ContentColorAlpha = surface.ContentColorAlpha
+)
+
+// RecordingSurface records drawing operations so they can be replayed onto
+// other surfaces or used as the source of a surface pattern. This allows a
+// drawing to be produced once and emitted to several backends.
+type RecordingSurface = surface.RecordingSurface
+
+// NewRecordingSurface creates a recording surface that clips recorded
+// operations to the rectangle at (x, y) with the given width and height.
+func NewRecordingSurface(content Content, x, y, width, height float64) (*RecordingSurface, error) {
+	surf, err := surface.NewRecordingSurface(content, x, y, width, height)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "recording")
+	}
+	return surf, nil
+}
+
+// NewUnboundedRecordingSurface creates a recording surface that records every
+// drawing operation regardless of where it lands.
+func NewUnboundedRecordingSurface(content Content) (*RecordingSurface, error) {
+	surf, err := surface.NewUnboundedRecordingSurface(content)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "recording")
+	}
+	return surf, nil
+}
+
 // Context is the main object used for drawing operations in Cairo.
 //
 // A Context maintains the graphics state including transformations, clip region,
@@ -249,6 +335,10 @@ func NewImageSurface(format Format, width, height int) (*surface.ImageSurface, e
 // on the same Context from multiple goroutines.
 type Context = context.Context
 
+// Rect is a rectangle in user-space coordinates, as returned by
+// Context.ClipRectangles.
+type Rect = context.Rect
+
 // NewContext creates a new Context for drawing on the given Surface.
 //
 // The Context maintains all graphics state for drawing operations. It must be
@@ -683,6 +773,47 @@ func NewRadialGradient(cx0, cy0, radius0, cx1, cy1, radius1 float64) (*RadialGra
 	return g, nil
 }
 
+// ConicGradient represents an angular (sweep) gradient, whose colors change
+// with the angle around a center point. It is useful for gauges, pie charts
+// and color wheels.
+//
+// Color stop offsets run from 0.0 at the start angle to 1.0 one full turn
+// later. Cairo has no native conic gradients, so the sweep is approximated
+// with mesh patches; add all color stops before setting the gradient as a
+// source.
+//
+// For more details, see the pattern package documentation.
+type ConicGradient = pattern.ConicGradient
+
+// NewConicGradient creates a new conic gradient centered on (cx, cy), whose
+// sweep starts at startAngle radians from the positive X axis.
+//
+// The returned gradient must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Example - Gauge from green to red over the top half:
+//
+//	gradient, err := cairo.NewConicGradient(100, 100, math.Pi)
+//	if err != nil {
+//	    return err
+//	}
+//	defer gradient.Close()
+//
+//	gradient.AddColorStopRGB(0.0, 0.0, 0.8, 0.0)  // Green at the left
+//	gradient.AddColorStopRGB(0.5, 0.8, 0.0, 0.0)  // Red at the right
+//
+//	ctx.SetSource(gradient)
+//	ctx.SetLineWidth(20)
+//	ctx.Arc(100, 100, 80, math.Pi, 2*math.Pi)
+//	ctx.Stroke()
+func NewConicGradient(cx, cy, startAngle float64) (*ConicGradient, error) {
+	g, err := pattern.NewConicGradient(cx, cy, startAngle)
+	if err != nil {
+		return nil, wrapPatternErr(err, "conic")
+	}
+	return g, nil
+}
+
 // SurfacePattern represents a pattern based on a Cairo surface (image).
 //
 // Surface patterns allow using existing surfaces (like images) as the source
@@ -842,6 +973,103 @@ func (s surfaceAdapter) Ptr() unsafe.Pointer {
 	return unsafe.Pointer(s.Surface.Ptr()) //nolint:gosec
 }
 
+// MeshPattern represents a mesh gradient pattern made of four-sided patches.
+//
+// Each patch is bounded by up to four lines or cubic Bézier curves and has a
+// color at each corner; Cairo interpolates the colors smoothly across the
+// patch. Mesh patterns express free-form gradients such as heatmap overlays
+// and realistic shading.
+//
+// Example:
+//
+//	mesh, err := cairo.NewMeshPattern()
+//	if err != nil {
+//	    return err
+//	}
+//	defer mesh.Close()
+//
+//	mesh.BeginPatch()
+//	mesh.MoveTo(0, 0)
+//	mesh.CurveTo(30, -30, 60, 30, 100, 0)
+//	mesh.LineTo(100, 100)
+//	mesh.LineTo(0, 100)
+//	mesh.SetCornerColorRGB(0, 1, 0, 0)
+//	mesh.SetCornerColorRGB(1, 0, 1, 0)
+//	mesh.SetCornerColorRGB(2, 0, 0, 1)
+//	mesh.SetCornerColorRGB(3, 1, 1, 0)
+//	mesh.EndPatch()
+//
+//	ctx.SetSource(mesh)
+//	ctx.Paint()
+//
+// For more details, see the pattern package documentation.
+type MeshPattern = pattern.MeshPattern
+
+// MeshPoint is a point in pattern space on the boundary or interior of a
+// mesh patch.
+type MeshPoint = pattern.MeshPoint
+
+// PatchPath is the boundary of a mesh patch, as returned by
+// MeshPattern.GetPath: a starting corner and four cubic Bézier sides.
+type PatchPath = pattern.PatchPath
+
+// NewMeshPattern creates a new, empty mesh pattern. Add patches with
+// BeginPatch, MoveTo/LineTo/CurveTo, SetCornerColorRGB(A) and EndPatch.
+//
+// The returned pattern must be closed with Close() when finished to release
+// Cairo resources.
+func NewMeshPattern() (*MeshPattern, error) {
+	m, err := pattern.NewMeshPattern()
+	if err != nil {
+		return nil, wrapPatternErr(err, "mesh")
+	}
+	return m, nil
+}
+
+// RasterSourcePattern represents a pattern whose pixels are supplied on
+// demand by Go callbacks. Cairo calls Acquire for the area it samples while
+// drawing and Release when it is done, so large images, map tiles or video
+// frames can be decoded lazily instead of held in an ImageSurface up front.
+//
+// Callback errors and panics are recovered; the affected drawing operation
+// paints nothing and the pattern's Status reports status.ReadError.
+//
+// For more details, see the pattern package documentation.
+type RasterSourcePattern = pattern.RasterSourcePattern
+
+// RasterSourceFuncs holds the Acquire, Release and Snapshot callbacks of a
+// RasterSourcePattern. Only Acquire is required.
+type RasterSourceFuncs = pattern.RasterSourceFuncs
+
+// NewRasterSourcePattern creates a new raster source pattern of the given
+// content and size, whose pixels are supplied by funcs.
+//
+// The returned pattern must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Example:
+//
+//	src, err := cairo.NewRasterSourcePattern(cairo.ContentColor, 256, 256,
+//		cairo.RasterSourceFuncs{
+//			Acquire: func(extents image.Rectangle) (*surface.ImageSurface, error) {
+//				return renderNoise(extents)
+//			},
+//		})
+//	if err != nil {
+//	    return err
+//	}
+//	defer src.Close()
+//
+//	ctx.SetSource(src)
+//	ctx.Paint()
+func NewRasterSourcePattern(content Content, width, height int, funcs RasterSourceFuncs) (*RasterSourcePattern, error) {
+	p, err := pattern.NewRasterSourcePattern(content, width, height, funcs)
+	if err != nil {
+		return nil, wrapPatternErr(err, "raster source")
+	}
+	return p, nil
+}
+
 // Extend defines how patterns behave outside their natural bounds.
 //
 // When a pattern (gradient or surface pattern) is used to paint an area
@@ -955,6 +1183,35 @@ const (
 	FillRuleEvenOdd FillRule = context.FillRuleEvenOdd
 )
 
+// Path is a copy of a context's path as a list of segments, returned by
+// Context.CopyPath and Context.CopyPathFlat and replayed with
+// Context.AppendPath.
+type Path = context.Path
+
+// PathSegment is a single element of a Path.
+type PathSegment = context.PathSegment
+
+// PathPoint is a point in a PathSegment, in user-space coordinates.
+type PathPoint = context.PathPoint
+
+// PathSegmentType identifies the kind of a PathSegment.
+type PathSegmentType = context.PathSegmentType
+
+const (
+	// PathSegmentMoveTo starts a new sub-path at its single point.
+	PathSegmentMoveTo PathSegmentType = context.PathSegmentMoveTo
+
+	// PathSegmentLineTo adds a straight line to its single point.
+	PathSegmentLineTo PathSegmentType = context.PathSegmentLineTo
+
+	// PathSegmentCurveTo adds a cubic Bézier spline through two control points
+	// to an end point.
+	PathSegmentCurveTo PathSegmentType = context.PathSegmentCurveTo
+
+	// PathSegmentClosePath closes the current sub-path. It has no points.
+	PathSegmentClosePath PathSegmentType = context.PathSegmentClosePath
+)
+
 // Operator controls how drawing operations combine with existing surface content.
 //
 // Cairo supports two classes of operators:
@@ -1096,3 +1353,218 @@ const (
 	// WeightBold selects a bold font face.
 	WeightBold Weight = font.WeightBold
 )
+
+// FontFace represents a font face at no particular size or transformation.
+// Create one with NewToyFontFace and combine it with a font matrix and CTM
+// using NewScaledFont.
+type FontFace = font.Face
+
+// FontType identifies the font backend a FontFace or ScaledFont was created with.
+type FontType = font.FontType
+
+// ScaledFont represents a font face at a particular size and transformation.
+// Use it to convert text into glyphs with TextToGlyphs, and to measure text,
+// glyphs and font metrics.
+type ScaledFont = font.ScaledFont
+
+// Glyph holds a glyph index and the position at which to draw it, for use
+// with Context.ShowGlyphs and Context.GlyphPath.
+type Glyph = font.Glyph
+
+// TextExtents holds the measurements of a string of text or glyphs, as
+// returned by Context.TextExtents and filled in by user font callbacks.
+type TextExtents = font.TextExtents
+
+// FontExtents holds the metrics of a font, as returned by
+// Context.FontExtents and filled in by user font callbacks.
+type FontExtents = font.FontExtents
+
+// TextCluster maps a run of bytes in a UTF-8 string to the glyphs that
+// represent it, for use with Context.ShowTextGlyphs.
+type TextCluster = font.TextCluster
+
+// TextClusterFlags specifies properties of a slice of text clusters.
+type TextClusterFlags = font.TextClusterFlags
+
+const (
+	// TextClusterFlagsNone maps clusters to glyphs in the same order as the text.
+	TextClusterFlagsNone TextClusterFlags = font.TextClusterFlagsNone
+
+	// TextClusterFlagBackward maps clusters to glyphs in reverse order, for
+	// right-to-left text whose glyphs are in visual order.
+	TextClusterFlagBackward TextClusterFlags = font.TextClusterFlagBackward
+)
+
+// NewToyFontFace creates a font face from a family name, slant and weight,
+// selecting the same face as Context.SelectFontFace.
+//
+// The returned face must be closed with Close() when finished to release
+// Cairo resources.
+func NewToyFontFace(family string, slant Slant, weight Weight) (*FontFace, error) {
+	f, err := font.NewToyFace(family, slant, weight)
+	if err != nil {
+		return nil, wrapFontErr(err, "toy")
+	}
+	return f, nil
+}
+
+// NewScaledFont creates a scaled font from face with the given font matrix
+// and CTM, using default font options.
+//
+// The returned scaled font must be closed with Close() when finished to
+// release Cairo resources.
+//
+// Example:
+//
+//	sf, err := cairo.NewScaledFont(face, matrix.NewScalingMatrix(24, 24), matrix.NewIdentityMatrix())
+//	if err != nil {
+//	    return err
+//	}
+//	defer sf.Close()
+//
+//	glyphs, err := sf.TextToGlyphs(10, 50, "Hello")
+func NewScaledFont(face *FontFace, fontMatrix, ctm *matrix.Matrix) (*ScaledFont, error) {
+	sf, err := font.NewScaledFont(face, fontMatrix, ctm)
+	if err != nil {
+		return nil, wrapFontErr(err, "scaled")
+	}
+	return sf, nil
+}
+
+// NewScaledFontWithOptions creates a scaled font from face with the given
+// font matrix, CTM and font options. If opts is nil, default options are used.
+//
+// The returned scaled font must be closed with Close() when finished to
+// release Cairo resources.
+func NewScaledFontWithOptions(face *FontFace, fontMatrix, ctm *matrix.Matrix, opts *FontOptions) (*ScaledFont, error) {
+	sf, err := font.NewScaledFontWithOptions(face, fontMatrix, ctm, opts)
+	if err != nil {
+		return nil, wrapFontErr(err, "scaled")
+	}
+	return sf, nil
+}
+
+// FontOptions holds the options used when rendering text: antialiasing,
+// subpixel order, hinting, color font handling and font variations. Apply
+// them with Context.SetFontOptions.
+type FontOptions = font.Options
+
+// NewFontOptions creates a new set of font options with every option set to
+// its default value.
+//
+// The returned options must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Example:
+//
+//	opts, err := cairo.NewFontOptions()
+//	if err != nil {
+//	    return err
+//	}
+//	defer opts.Close()
+//
+//	opts.SetHintStyle(cairo.HintStyleFull)
+//	opts.SetHintMetrics(cairo.HintMetricsOn)
+//	ctx.SetFontOptions(opts)
+func NewFontOptions() (*FontOptions, error) {
+	opts, err := font.NewOptions()
+	if err != nil {
+		return nil, wrapFontErr(err, "options")
+	}
+	return opts, nil
+}
+
+// Antialias specifies the type of antialiasing used when rendering text.
+type Antialias = font.Antialias
+
+const (
+	// AntialiasDefault uses the default antialiasing for the font backend and target surface.
+	AntialiasDefault Antialias = font.AntialiasDefault
+
+	// AntialiasNone uses a bilevel alpha mask.
+	AntialiasNone Antialias = font.AntialiasNone
+
+	// AntialiasGray performs single-color antialiasing.
+	AntialiasGray Antialias = font.AntialiasGray
+
+	// AntialiasSubpixel performs antialiasing using the subpixel order of LCD panels.
+	AntialiasSubpixel Antialias = font.AntialiasSubpixel
+
+	// AntialiasFast prefers speed over quality.
+	AntialiasFast Antialias = font.AntialiasFast
+
+	// AntialiasGood balances quality against performance.
+	AntialiasGood Antialias = font.AntialiasGood
+
+	// AntialiasBest renders at the highest quality.
+	AntialiasBest Antialias = font.AntialiasBest
+)
+
+// SubpixelOrder specifies the order of color elements within each pixel,
+// used with AntialiasSubpixel.
+type SubpixelOrder = font.SubpixelOrder
+
+const (
+	// SubpixelOrderDefault uses the default subpixel order for the target device.
+	SubpixelOrderDefault SubpixelOrder = font.SubpixelOrderDefault
+
+	// SubpixelOrderRGB arranges subpixels horizontally with red at the left.
+	SubpixelOrderRGB SubpixelOrder = font.SubpixelOrderRGB
+
+	// SubpixelOrderBGR arranges subpixels horizontally with blue at the left.
+	SubpixelOrderBGR SubpixelOrder = font.SubpixelOrderBGR
+
+	// SubpixelOrderVRGB arranges subpixels vertically with red at the top.
+	SubpixelOrderVRGB SubpixelOrder = font.SubpixelOrderVRGB
+
+	// SubpixelOrderVBGR arranges subpixels vertically with blue at the top.
+	SubpixelOrderVBGR SubpixelOrder = font.SubpixelOrderVBGR
+)
+
+// HintStyle specifies how strongly glyph outlines are fitted to the pixel grid.
+type HintStyle = font.HintStyle
+
+const (
+	// HintStyleDefault uses the default hint style for the font backend and target device.
+	HintStyleDefault HintStyle = font.HintStyleDefault
+
+	// HintStyleNone does not hint outlines.
+	HintStyleNone HintStyle = font.HintStyleNone
+
+	// HintStyleSlight hints outlines slightly, retaining fidelity to the original shapes.
+	HintStyleSlight HintStyle = font.HintStyleSlight
+
+	// HintStyleMedium hints outlines with medium strength.
+	HintStyleMedium HintStyle = font.HintStyleMedium
+
+	// HintStyleFull hints outlines to maximize contrast.
+	HintStyleFull HintStyle = font.HintStyleFull
+)
+
+// HintMetrics specifies whether font metrics are rounded to integer device-space values.
+type HintMetrics = font.HintMetrics
+
+const (
+	// HintMetricsDefault uses the default for the font backend and target device.
+	HintMetricsDefault HintMetrics = font.HintMetricsDefault
+
+	// HintMetricsOff does not hint font metrics.
+	HintMetricsOff HintMetrics = font.HintMetricsOff
+
+	// HintMetricsOn hints font metrics.
+	HintMetricsOn HintMetrics = font.HintMetricsOn
+)
+
+// ColorMode specifies whether color fonts, such as emoji fonts, are rendered in color.
+type ColorMode = font.ColorMode
+
+const (
+	// ColorModeDefault uses the default color mode for the font backend and target device.
+	ColorModeDefault ColorMode = font.ColorModeDefault
+
+	// ColorModeNoColor renders color glyphs as outlines.
+	ColorModeNoColor ColorMode = font.ColorModeNoColor
+
+	// ColorModeColor renders color glyphs in color.
+	ColorModeColor ColorMode = font.ColorModeColor
+)
@@ -0,0 +1,43 @@
+// ABOUTME: Re-exports Device and DeviceType, wrapping cairo_device_t, from the device package.
+// ABOUTME: Enables Acquire/Release, Flush and Finish on surface devices through the root cairo package.
+
+package cairo
+
+import "github.com/mikowitz/cairo/device"
+
+// Device is the backend resource shared by a family of surfaces, such as a
+// Script. Use Surface.GetDevice to get the device of a surface. See the
+// device package.
+type Device = device.Device
+
+// DeviceType identifies the backend of a Device.
+type DeviceType = device.DeviceType
+
+const (
+	// DeviceTypeDRM is a Direct Rendering Manager device.
+	DeviceTypeDRM DeviceType = device.DeviceTypeDRM
+
+	// DeviceTypeGL is an OpenGL device.
+	DeviceTypeGL DeviceType = device.DeviceTypeGL
+
+	// DeviceTypeScript is a cairo-script trace.
+	DeviceTypeScript DeviceType = device.DeviceTypeScript
+
+	// DeviceTypeXCB is an XCB connection.
+	DeviceTypeXCB DeviceType = device.DeviceTypeXCB
+
+	// DeviceTypeXlib is an Xlib display.
+	DeviceTypeXlib DeviceType = device.DeviceTypeXlib
+
+	// DeviceTypeXML is an XML trace.
+	DeviceTypeXML DeviceType = device.DeviceTypeXML
+
+	// DeviceTypeCOGL is a Cogl context.
+	DeviceTypeCOGL DeviceType = device.DeviceTypeCOGL
+
+	// DeviceTypeWin32 is a Win32 device.
+	DeviceTypeWin32 DeviceType = device.DeviceTypeWin32
+
+	// DeviceTypeInvalid is returned for a closed Device.
+	DeviceTypeInvalid DeviceType = device.DeviceTypeInvalid
+)
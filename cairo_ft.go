@@ -0,0 +1,53 @@
+// ABOUTME: Re-exports the FreeType font face constructors from the font package.
+// ABOUTME: Enables loading fonts from files and embedded bytes through the root cairo package.
+
+//go:build !noft
+
+package cairo
+
+import "github.com/mikowitz/cairo/font"
+
+// NewFontFaceFromFile creates a font face from the font file at path using
+// Cairo's FreeType backend. index selects the face within a font collection;
+// use 0 for single-face files. Select the face with Context.SetFontFace.
+//
+// The returned face must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Requires Cairo's FreeType backend (cairo-ft pkg-config entry).
+func NewFontFaceFromFile(path string, index int) (*FontFace, error) {
+	face, err := font.NewFaceFromFile(path, index)
+	if err != nil {
+		return nil, wrapFontErr(err, "ft")
+	}
+	return face, nil
+}
+
+// NewFontFaceFromBytes creates a font face from font data held in memory,
+// such as a font embedded with go:embed, using Cairo's FreeType backend. The
+// data is copied.
+//
+// The returned face must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Requires Cairo's FreeType backend (cairo-ft pkg-config entry).
+//
+// Example:
+//
+//	//go:embed fonts/Brand-Regular.ttf
+//	var brandRegular []byte
+//
+//	face, err := cairo.NewFontFaceFromBytes(brandRegular)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+//
+//	ctx.SetFontFace(face)
+func NewFontFaceFromBytes(data []byte) (*FontFace, error) {
+	face, err := font.NewFaceFromBytes(data)
+	if err != nil {
+		return nil, wrapFontErr(err, "ft")
+	}
+	return face, nil
+}
@@ -0,0 +1,37 @@
+// ABOUTME: Tests for the FreeType font face constructors re-exported from the root cairo package.
+// ABOUTME: Covers FontError wrapping for missing files and invalid font data.
+
+//go:build !noft
+
+package cairo_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewFontFaceFromFileError verifies a missing file is reported as a
+// *FontError wrapping status.FileNotFound.
+func TestNewFontFaceFromFileError(t *testing.T) {
+	face, err := cairo.NewFontFaceFromFile(filepath.Join(t.TempDir(), "missing.otf"), 0)
+	assert.Nil(t, face)
+
+	var fe *cairo.FontError
+	require.True(t, errors.As(err, &fe))
+	assert.Equal(t, "ft", fe.FontType)
+	assert.True(t, errors.Is(err, status.FileNotFound))
+}
+
+// TestNewFontFaceFromBytesError verifies invalid font data is reported as a
+// *FontError wrapping status.FreetypeError.
+func TestNewFontFaceFromBytesError(t *testing.T) {
+	face, err := cairo.NewFontFaceFromBytes([]byte("definitely not a font"))
+	assert.Nil(t, face)
+	assert.True(t, errors.Is(err, &cairo.FontError{Status: status.FreetypeError, FontType: "ft"}))
+}
@@ -0,0 +1,70 @@
+// ABOUTME: Tests for scaled fonts and glyph rendering through the root cairo package.
+// ABOUTME: Covers FontError wrapping and drawing repositioned glyphs with a Context.
+
+package cairo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewScaledFontError verifies creation errors are wrapped in *FontError.
+func TestNewScaledFontError(t *testing.T) {
+	face, err := cairo.NewToyFontFace("sans-serif", cairo.SlantNormal, cairo.WeightNormal)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	_, err = cairo.NewScaledFont(face, matrix.NewScalingMatrix(0, 0), matrix.NewIdentityMatrix())
+	require.Error(t, err)
+
+	var fe *cairo.FontError
+	require.True(t, errors.As(err, &fe))
+	assert.Equal(t, "scaled", fe.FontType)
+	assert.True(t, errors.Is(err, status.InvalidMatrix))
+}
+
+// TestShowGlyphsLetterSpacing verifies spreading glyphs apart widens the
+// inked area compared with the text as laid out by the font.
+func TestShowGlyphsLetterSpacing(t *testing.T) {
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 300, 60)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	ctx.SelectFontFace("sans-serif", cairo.SlantNormal, cairo.WeightNormal)
+	ctx.SetFontSize(20)
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	glyphs, err := sf.TextToGlyphs(10, 40, "spaced")
+	require.NoError(t, err)
+	natural := ctx.GlyphExtents(glyphs)
+
+	for i := range glyphs {
+		glyphs[i].X += float64(i) * 10
+	}
+	spaced := ctx.GlyphExtents(glyphs)
+	assert.InDelta(t, natural.Width+50, spaced.Width, 1e-6)
+
+	ctx.SetSourceRGB(0, 0, 0)
+	ctx.ShowGlyphs(glyphs)
+	require.Equal(t, status.Success, ctx.Status())
+}
@@ -0,0 +1,67 @@
+// ABOUTME: Re-exports the paragraph layout and rich text types from the layout package.
+// ABOUTME: Enables wrapping, aligning and drawing styled text through the root cairo package.
+
+package cairo
+
+import "github.com/mikowitz/cairo/layout"
+
+// TextLayout is text broken into lines that fit a width, measured with a
+// Context's font and ready to draw with Draw. See the layout package.
+type TextLayout = layout.Layout
+
+// TextLayoutOptions controls line width, alignment, line height, the maximum
+// number of lines and ellipsis truncation of a TextLayout.
+type TextLayoutOptions = layout.Options
+
+// TextLine is a measured line of a TextLayout.
+type TextLine = layout.Line
+
+// TextAlignment specifies how the lines of a TextLayout are positioned
+// horizontally.
+type TextAlignment = layout.Alignment
+
+const (
+	// AlignLeft places lines against the left edge of the layout.
+	AlignLeft TextAlignment = layout.AlignLeft
+
+	// AlignCenter centers lines within the layout.
+	AlignCenter TextAlignment = layout.AlignCenter
+
+	// AlignRight places lines against the right edge of the layout.
+	AlignRight TextAlignment = layout.AlignRight
+
+	// AlignJustify stretches the spaces between words so that lines fill
+	// the layout width.
+	AlignJustify TextAlignment = layout.AlignJustify
+)
+
+// NewTextLayout breaks text into lines with the current font of ctx.
+//
+// Example:
+//
+//	l, err := cairo.NewTextLayout(ctx, summary, cairo.TextLayoutOptions{
+//	    Width:    300,
+//	    MaxLines: 4,
+//	    Ellipsis: "…",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	l.Draw(ctx, 24, 24)
+func NewTextLayout(ctx *Context, text string, opts TextLayoutOptions) (*TextLayout, error) {
+	l, err := layout.New(ctx, text, opts)
+	if err != nil {
+		return nil, wrapContextErr(err, "layout")
+	}
+	return l, nil
+}
+
+// RichText is a single line of text made of spans with different fonts,
+// sizes, sources and decorations, drawn on a shared baseline.
+type RichText = layout.RichText
+
+// TextSpan is a run of text in a RichText drawn with a single style.
+type TextSpan = layout.Span
+
+// RichTextExtents holds the measurements of a RichText.
+type RichTextExtents = layout.RichTextExtents
@@ -0,0 +1,151 @@
+// ABOUTME: Tests for MeshPattern and ConicGradient through the root cairo package, painting with a Context.
+// ABOUTME: Covers corner color interpolation, conic sweeps, and round-tripping through GetSource.
+
+package cairo_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMeshPatternPaint verifies a mesh pattern set as the source paints its
+// corner colors at the corners of the patch and nothing outside it.
+func TestMeshPatternPaint(t *testing.T) {
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 120, 120)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	mesh, err := cairo.NewMeshPattern()
+	require.NoError(t, err)
+	defer func() {
+		_ = mesh.Close()
+	}()
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(100, 0)
+	mesh.LineTo(100, 100)
+	mesh.LineTo(0, 100)
+	mesh.SetCornerColorRGB(0, 1, 0, 0)
+	mesh.SetCornerColorRGB(1, 0, 1, 0)
+	mesh.SetCornerColorRGB(2, 0, 0, 1)
+	mesh.SetCornerColorRGB(3, 1, 1, 0)
+	mesh.EndPatch()
+
+	ctx.SetSource(mesh)
+	ctx.Paint()
+	require.Equal(t, status.Success, ctx.Status())
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+
+	near := func(x, y int, r, g, b uint8) {
+		t.Helper()
+		px := img.At(x, y).(color.RGBA)
+		assert.InDelta(t, r, px.R, 8, "red at (%d, %d)", x, y)
+		assert.InDelta(t, g, px.G, 8, "green at (%d, %d)", x, y)
+		assert.InDelta(t, b, px.B, 8, "blue at (%d, %d)", x, y)
+		assert.Equal(t, uint8(0xff), px.A, "alpha at (%d, %d)", x, y)
+	}
+	near(0, 0, 0xff, 0, 0)
+	near(99, 0, 0, 0xff, 0)
+	near(99, 99, 0, 0, 0xff)
+	near(0, 99, 0xff, 0xff, 0)
+
+	assert.Equal(t, color.RGBA{}, img.At(110, 110), "area outside the patch should be untouched")
+}
+
+// TestMeshPatternGetSource verifies a mesh source is returned as a *MeshPattern.
+func TestMeshPatternGetSource(t *testing.T) {
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 10, 10)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	mesh, err := cairo.NewMeshPattern()
+	require.NoError(t, err)
+	ctx.SetSource(mesh)
+	require.NoError(t, mesh.Close())
+
+	src, err := ctx.GetSource()
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+
+	assert.IsType(t, &cairo.MeshPattern{}, src)
+	assert.Equal(t, pattern.PatternTypeMesh, src.GetType())
+}
+
+// TestConicGradientPaint verifies colors follow the angle around the center,
+// including a sharp transition at repeated stop offsets.
+func TestConicGradientPaint(t *testing.T) {
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 100, 100)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	gradient, err := cairo.NewConicGradient(50, 50, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = gradient.Close()
+	}()
+
+	// Red sweeping to green over the first half turn, then solid blue.
+	gradient.AddColorStopRGB(0, 1, 0, 0)
+	gradient.AddColorStopRGB(0.5, 0, 1, 0)
+	gradient.AddColorStopRGB(0.5, 0, 0, 1)
+	gradient.AddColorStopRGB(1, 0, 0, 1)
+
+	ctx.SetSource(gradient)
+	ctx.Paint()
+	require.Equal(t, status.Success, ctx.Status())
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		x, y    int
+		r, g, b uint8
+	}{
+		{"QuarterTurn", 50, 90, 0x80, 0x80, 0},
+		{"ThreeQuarterTurn", 50, 10, 0, 0, 0xff},
+		{"JustBeforeHalfTurn", 10, 52, 0, 0xff, 0},
+		{"JustAfterHalfTurn", 10, 48, 0, 0, 0xff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			px := img.At(tt.x, tt.y).(color.RGBA)
+			assert.InDelta(t, tt.r, px.R, 12)
+			assert.InDelta(t, tt.g, px.G, 12)
+			assert.InDelta(t, tt.b, px.B, 12)
+			assert.Equal(t, uint8(0xff), px.A)
+		})
+	}
+}
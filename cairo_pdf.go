@@ -1,11 +1,15 @@
-// ABOUTME: Re-exports PDFSurface type and NewPDFSurface constructor from the surface package.
+// ABOUTME: Re-exports PDFSurface type and the NewPDFSurface and NewPDFSurfaceForWriter constructors from the surface package.
 // ABOUTME: Enables PDF surface usage through the root cairo package without a sub-package import.
 
 //go:build !nopdf
 
 package cairo
 
-import "github.com/mikowitz/cairo/surface"
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/surface"
+)
 
 // PDFSurface is a surface that writes drawing operations to a PDF file.
 // Dimensions are specified in points, where 1 point equals 1/72 of an inch.
@@ -31,3 +35,16 @@ func NewPDFSurface(filename string, widthPt, heightPt float64) (*PDFSurface, err
 	}
 	return surf, nil
 }
+
+// NewPDFSurfaceForWriter creates a new PDF surface writing the document to w,
+// such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+// Close the surface to finish the document; Close returns a write error if
+// any write to w failed.
+func NewPDFSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*PDFSurface, error) {
+	surf, err := surface.NewPDFSurfaceForWriter(w, widthPt, heightPt)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "pdf")
+	}
+	return surf, nil
+}
@@ -6,6 +6,7 @@
 package cairo_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -67,3 +68,31 @@ func TestNewPDFSurfaceInvalidPathViaRootPackage(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, surf)
 }
+
+// TestNewPDFSurfaceForWriterViaRootPackage verifies a document drawn through a
+// context is written to an io.Writer when the surface is closed.
+func TestNewPDFSurfaceForWriterViaRootPackage(t *testing.T) {
+	var buf bytes.Buffer
+
+	surf, err := cairo.NewPDFSurfaceForWriter(&buf, 612, 792)
+	require.NoError(t, err)
+
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	ctx.SetSourceRGB(0.0, 0.0, 1.0)
+	ctx.Rectangle(10, 10, 100, 100)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	require.NoError(t, surf.Close())
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
+
+// TestNewPDFSurfaceForWriterNilViaRootPackage verifies a nil writer returns a SurfaceError.
+func TestNewPDFSurfaceForWriterNilViaRootPackage(t *testing.T) {
+	surf, err := cairo.NewPDFSurfaceForWriter(nil, 612, 792)
+	assert.Nil(t, surf)
+	var surfErr *cairo.SurfaceError
+	require.ErrorAs(t, err, &surfErr)
+	assert.Equal(t, "pdf", surfErr.SurfaceType)
+}
@@ -0,0 +1,72 @@
+// ABOUTME: Re-exports PSSurface, PSLevel types/constants, and NewPSSurface/NewPSSurfaceForWriter/PSLevels
+// ABOUTME: helpers from the surface package for use via the root cairo package.
+
+//go:build !nops
+
+package cairo
+
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/surface"
+)
+
+// PSSurface is a surface that writes drawing operations to a PostScript file.
+// Dimensions are specified in points, where 1 point equals 1/72 of an inch.
+// The coordinate origin is at the top-left corner of each page.
+//
+// Use NewPSSurface to create a PostScript surface. Call SetEPS to produce
+// Encapsulated PostScript instead. Call ShowPage to end one page and begin the
+// next. Close the surface when finished to flush and finalize the document.
+//
+// Requires Cairo's PostScript backend (cairo-ps pkg-config entry).
+type PSSurface = surface.PSSurface
+
+// PSLevel specifies the language level of the PostScript specification.
+// Pass one of the PSLevel constants to PSSurface.RestrictToLevel.
+type PSLevel = surface.PSLevel
+
+const (
+	// PSLevel2 generates output using language level 2 of the PostScript specification.
+	PSLevel2 PSLevel = surface.PSLevel2
+	// PSLevel3 generates output using language level 3 of the PostScript specification.
+	PSLevel3 PSLevel = surface.PSLevel3
+)
+
+// PSLevels returns the list of PostScript language levels supported by the Cairo library.
+func PSLevels() []PSLevel {
+	return surface.PSLevels()
+}
+
+// PSLevelToString returns the human-readable name of the PostScript level
+// (e.g., "PS Level 2" or "PS Level 3"). Returns an empty string for unknown levels.
+func PSLevelToString(level PSLevel) string {
+	return surface.PSLevelToString(level)
+}
+
+// NewPSSurface creates a new PostScript surface writing to filename.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+// Returns an error if Cairo cannot create the surface (e.g., invalid path).
+//
+// Requires the Cairo PostScript backend. On Debian/Ubuntu: libcairo2-dev.
+// On macOS: brew install cairo (includes PostScript support by default).
+func NewPSSurface(filename string, widthPt, heightPt float64) (*PSSurface, error) {
+	surf, err := surface.NewPSSurface(filename, widthPt, heightPt)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "ps")
+	}
+	return surf, nil
+}
+
+// NewPSSurfaceForWriter creates a new PostScript surface writing the document
+// to w, such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+// Close the surface to finish the document; Close returns a write error if
+// any write to w failed.
+func NewPSSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*PSSurface, error) {
+	surf, err := surface.NewPSSurfaceForWriter(w, widthPt, heightPt)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "ps")
+	}
+	return surf, nil
+}
@@ -0,0 +1,70 @@
+// ABOUTME: Tests for the PSSurface type, PSLevel type, and NewPSSurface constructors
+// ABOUTME: re-exported from the root cairo package.
+
+//go:build !nops
+
+package cairo_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewPSSurfaceViaRootPackage verifies NewPSSurface works through the root package,
+// including EPS mode and integration with NewContext.
+func TestNewPSSurfaceViaRootPackage(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "output.eps")
+
+	surf, err := cairo.NewPSSurface(filename, 200, 200)
+	require.NoError(t, err)
+	surf.SetEPS(true)
+	surf.RestrictToLevel(cairo.PSLevel2)
+
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	ctx.SetSourceRGB(1.0, 0.0, 0.0)
+	ctx.Rectangle(10, 10, 100, 100)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	require.NoError(t, surf.Close())
+
+	data, err := os.ReadFile(filename) //nolint:gosec // filename is from t.TempDir()
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%!PS-Adobe-3.0 EPSF-3.0")))
+	assert.Contains(t, string(data), "%%BoundingBox: 10 ")
+}
+
+// TestNewPSSurfaceForWriterViaRootPackage verifies PostScript output to an io.Writer.
+func TestNewPSSurfaceForWriterViaRootPackage(t *testing.T) {
+	var buf bytes.Buffer
+
+	surf, err := cairo.NewPSSurfaceForWriter(&buf, 612, 792)
+	require.NoError(t, err)
+	surf.DSCComment("%%Title: Root Package")
+	require.NoError(t, surf.Close())
+
+	assert.Contains(t, buf.String(), "%%Title: Root Package")
+}
+
+// TestNewPSSurfaceInvalidPathViaRootPackage verifies that an invalid path returns a SurfaceError.
+func TestNewPSSurfaceInvalidPathViaRootPackage(t *testing.T) {
+	surf, err := cairo.NewPSSurface("/nonexistent/dir/output.ps", 612, 792)
+	assert.Nil(t, surf)
+	var surfErr *cairo.SurfaceError
+	require.ErrorAs(t, err, &surfErr)
+	assert.Equal(t, "ps", surfErr.SurfaceType)
+}
+
+// TestPSLevelsViaRootPackage verifies the level helpers are re-exported.
+func TestPSLevelsViaRootPackage(t *testing.T) {
+	assert.Contains(t, cairo.PSLevels(), cairo.PSLevel3)
+	assert.Equal(t, "PS Level 3", cairo.PSLevelToString(cairo.PSLevel3))
+}
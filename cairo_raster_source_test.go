@@ -0,0 +1,144 @@
+// ABOUTME: Tests for RasterSourcePattern through the root cairo package, painting with a Context.
+// ABOUTME: Covers lazily supplied pixels, release of acquired surfaces, and recovery from callback panics.
+
+package cairo_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paintRasterSource paints src onto a new 20x20 image surface and returns it.
+func paintRasterSource(t *testing.T, src *cairo.RasterSourcePattern) *surface.ImageSurface {
+	t.Helper()
+
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 20, 20)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = surf.Close()
+	})
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	ctx.SetSource(src)
+	ctx.Paint()
+	return surf
+}
+
+// TestRasterSourcePatternPaint verifies Cairo draws the pixels supplied by
+// Acquire and hands the surface back to Release.
+func TestRasterSourcePatternPaint(t *testing.T) {
+	var requested []image.Rectangle
+	released := 0
+
+	src, err := cairo.NewRasterSourcePattern(cairo.ContentColorAlpha, 10, 10, cairo.RasterSourceFuncs{
+		Acquire: func(extents image.Rectangle) (*surface.ImageSurface, error) {
+			requested = append(requested, extents)
+
+			s, err := cairo.NewImageSurface(cairo.FormatARGB32, extents.Dx(), extents.Dy())
+			if err != nil {
+				return nil, err
+			}
+			img, err := s.Image()
+			if err != nil {
+				return nil, err
+			}
+			for y := range extents.Dy() {
+				for x := range extents.Dx() {
+					img.Set(x, y, color.RGBA{G: 0xff, A: 0xff})
+				}
+			}
+			s.MarkDirty()
+			return s, nil
+		},
+		Release: func(s *surface.ImageSurface) {
+			released++
+			_ = s.Close()
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+
+	surf := paintRasterSource(t, src)
+
+	require.NotEmpty(t, requested, "Acquire should be called while painting")
+	assert.Equal(t, len(requested), released, "every acquired surface should be released")
+	assert.Equal(t, status.Success, src.Status())
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{G: 0xff, A: 0xff}, img.At(5, 5))
+	assert.Equal(t, color.RGBA{}, img.At(15, 15), "outside the pattern should be transparent")
+}
+
+// TestRasterSourcePatternPanic verifies a panicking Acquire paints nothing
+// and is reported through the pattern's status.
+func TestRasterSourcePatternPanic(t *testing.T) {
+	src, err := cairo.NewRasterSourcePattern(cairo.ContentColorAlpha, 10, 10, cairo.RasterSourceFuncs{
+		Acquire: func(image.Rectangle) (*surface.ImageSurface, error) {
+			panic("corrupt tile")
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+
+	surf := paintRasterSource(t, src)
+
+	assert.Equal(t, status.ReadError, src.Status())
+	require.Error(t, src.Err())
+	assert.Contains(t, src.Err().Error(), "corrupt tile")
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{}, img.At(5, 5))
+}
+
+// TestRasterSourcePatternGetSource verifies a raster source set as the
+// source is returned as a *RasterSourcePattern sharing the callback state.
+func TestRasterSourcePatternGetSource(t *testing.T) {
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 10, 10)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	src, err := cairo.NewRasterSourcePattern(cairo.ContentColorAlpha, 10, 10, cairo.RasterSourceFuncs{
+		Acquire: func(image.Rectangle) (*surface.ImageSurface, error) {
+			panic("unavailable")
+		},
+	})
+	require.NoError(t, err)
+	ctx.SetSource(src)
+	require.NoError(t, src.Close())
+
+	got, err := ctx.GetSource()
+	require.NoError(t, err)
+	defer func() {
+		_ = got.Close()
+	}()
+	require.IsType(t, &cairo.RasterSourcePattern{}, got)
+	assert.Equal(t, pattern.PatternTypeRasterSource, got.GetType())
+
+	ctx.Paint()
+	assert.Equal(t, status.ReadError, got.Status())
+}
@@ -0,0 +1,126 @@
+// ABOUTME: Tests for RecordingSurface through the root cairo package, drawing with a Context.
+// ABOUTME: Covers ink extents, replay onto image and PNG output, and use as a pattern source.
+
+package cairo_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRecording returns an unbounded recording of a red 40x30 rectangle at (10, 20).
+func newTestRecording(t *testing.T) *cairo.RecordingSurface {
+	t.Helper()
+
+	rec, err := cairo.NewUnboundedRecordingSurface(cairo.ContentColorAlpha)
+	require.NoError(t, err)
+
+	ctx, err := cairo.NewContext(rec)
+	require.NoError(t, err)
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(10, 20, 40, 30)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	return rec
+}
+
+// TestRecordingSurfaceInkExtents verifies ink extents cover the recorded drawing.
+func TestRecordingSurfaceInkExtents(t *testing.T) {
+	rec := newTestRecording(t)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	x, y, w, h := rec.InkExtents()
+	assert.Equal(t, []float64{10, 20, 40, 30}, []float64{x, y, w, h})
+}
+
+// TestRecordingSurfaceBoundedClipsDrawing verifies drawing outside the bounds is discarded.
+func TestRecordingSurfaceBoundedClipsDrawing(t *testing.T) {
+	rec, err := cairo.NewRecordingSurface(cairo.ContentColorAlpha, 0, 0, 30, 30)
+	require.NoError(t, err)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	ctx, err := cairo.NewContext(rec)
+	require.NoError(t, err)
+	ctx.Rectangle(10, 10, 100, 100)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	x, y, w, h := rec.InkExtents()
+	assert.Equal(t, []float64{10, 10, 20, 20}, []float64{x, y, w, h})
+}
+
+// TestRecordingSurfaceReplay verifies one recording can be replayed onto
+// several targets with the same result.
+func TestRecordingSurfaceReplay(t *testing.T) {
+	rec := newTestRecording(t)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	for _, format := range []cairo.Format{cairo.FormatARGB32, cairo.FormatRGB24} {
+		t.Run(format.String(), func(t *testing.T) {
+			target, err := cairo.NewImageSurface(format, 100, 100)
+			require.NoError(t, err)
+			defer func() {
+				_ = target.Close()
+			}()
+
+			require.NoError(t, rec.Replay(target))
+
+			img, err := target.Image()
+			require.NoError(t, err)
+			assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(30, 30))
+			r, g, b, _ := img.At(5, 5).RGBA()
+			assert.Equal(t, []uint32{0, 0, 0}, []uint32{r, g, b})
+
+			var buf bytes.Buffer
+			require.NoError(t, target.WriteToPNGWriter(&buf))
+			_, err = png.Decode(&buf)
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestRecordingSurfaceAsPattern verifies a recording can be used as a
+// transformed pattern source.
+func TestRecordingSurfaceAsPattern(t *testing.T) {
+	rec := newTestRecording(t)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	pat, err := cairo.NewSurfacePattern(rec)
+	require.NoError(t, err)
+	defer func() {
+		_ = pat.Close()
+	}()
+
+	target, err := cairo.NewImageSurface(cairo.FormatARGB32, 100, 100)
+	require.NoError(t, err)
+	defer func() {
+		_ = target.Close()
+	}()
+
+	ctx, err := cairo.NewContext(target)
+	require.NoError(t, err)
+	ctx.Translate(40, 0)
+	ctx.SetSource(pat)
+	ctx.Paint()
+	require.NoError(t, ctx.Close())
+
+	img, err := target.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(70, 30))
+	assert.Equal(t, color.RGBA{}, img.At(30, 30))
+}
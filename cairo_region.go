@@ -0,0 +1,54 @@
+// ABOUTME: Re-exports regions of integer device space (cairo_region_t) from the region package.
+// ABOUTME: Enables damage tracking and hit zones through the root cairo package.
+
+package cairo
+
+import "github.com/mikowitz/cairo/region"
+
+// Region is an area of integer device space made up of non-overlapping
+// rectangles. See the region package.
+type Region = region.Region
+
+// RectangleInt is a rectangle with integer coordinates, as used by Region.
+type RectangleInt = region.Rectangle
+
+// RegionOverlap describes how a rectangle overlaps a Region.
+type RegionOverlap = region.Overlap
+
+const (
+	// RegionOverlapIn means the rectangle is entirely inside the region.
+	RegionOverlapIn RegionOverlap = region.OverlapIn
+
+	// RegionOverlapOut means the rectangle is entirely outside the region.
+	RegionOverlapOut RegionOverlap = region.OverlapOut
+
+	// RegionOverlapPart means the rectangle is partly inside and partly
+	// outside the region.
+	RegionOverlapPart RegionOverlap = region.OverlapPart
+)
+
+// NewRegion creates an empty region.
+//
+// Example:
+//
+//	damage, err := cairo.NewRegion()
+//	if err != nil {
+//	    return err
+//	}
+//	defer damage.Close()
+//
+//	damage.UnionRectangle(cairo.RectangleInt{X: 10, Y: 10, Width: 40, Height: 20})
+//	surf.MarkDirtyRegion(damage)
+func NewRegion() (*Region, error) {
+	return region.NewRegion()
+}
+
+// NewRegionRectangle creates a region covering rect.
+func NewRegionRectangle(rect RectangleInt) (*Region, error) {
+	return region.NewRegionRectangle(rect)
+}
+
+// NewRegionRectangles creates a region covering the union of rects.
+func NewRegionRectangles(rects []RectangleInt) (*Region, error) {
+	return region.NewRegionRectangles(rects)
+}
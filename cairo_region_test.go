@@ -0,0 +1,51 @@
+// ABOUTME: Tests for the region constructors re-exported from the root cairo package.
+// ABOUTME: Covers building a region from rectangles and marking it dirty on a surface.
+
+package cairo_test
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewRegionViaRootPackage verifies regions can be built and used to mark
+// a surface dirty.
+func TestNewRegionViaRootPackage(t *testing.T) {
+	r, err := cairo.NewRegionRectangles([]cairo.RectangleInt{
+		{X: 0, Y: 0, Width: 10, Height: 10},
+		{X: 10, Y: 0, Width: 10, Height: 10},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = r.Close()
+	}()
+	assert.Equal(t, []cairo.RectangleInt{{X: 0, Y: 0, Width: 20, Height: 10}}, r.Rectangles())
+	assert.Equal(t, cairo.RegionOverlapPart, r.ContainsRectangle(cairo.RectangleInt{X: 15, Y: 5, Width: 10, Height: 10}))
+
+	hit, err := cairo.NewRegionRectangle(cairo.RectangleInt{X: 5, Y: 5, Width: 5, Height: 5})
+	require.NoError(t, err)
+	defer func() {
+		_ = hit.Close()
+	}()
+	require.NoError(t, r.Subtract(hit))
+	assert.False(t, r.ContainsPoint(7, 7))
+
+	empty, err := cairo.NewRegion()
+	require.NoError(t, err)
+	defer func() {
+		_ = empty.Close()
+	}()
+	assert.True(t, empty.IsEmpty())
+
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 40, 20)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	surf.MarkDirtyRegion(r)
+	assert.Equal(t, status.Success, surf.Status())
+}
@@ -0,0 +1,93 @@
+// ABOUTME: Re-exports Script, ScriptSurface, ScriptMode and their constructors from the surface package.
+// ABOUTME: Enables capturing Cairo call streams as cairo-script traces through the root cairo package.
+
+//go:build !noscript
+
+package cairo
+
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/surface"
+)
+
+// Script is a device that records the Cairo calls made on its surfaces as a
+// cairo-script trace, which Cairo's csi-replay tool can replay.
+//
+// Requires Cairo's script backend (cairo-script pkg-config entry).
+type Script = surface.Script
+
+// ScriptSurface is a surface whose drawing is recorded into a Script.
+type ScriptSurface = surface.ScriptSurface
+
+// ScriptMode specifies how a Script encodes its trace.
+type ScriptMode = surface.ScriptMode
+
+const (
+	// ScriptModeASCII writes the trace as readable text.
+	ScriptModeASCII ScriptMode = surface.ScriptModeASCII
+	// ScriptModeBinary writes the trace with binary data left unencoded.
+	ScriptModeBinary ScriptMode = surface.ScriptModeBinary
+)
+
+// NewScript creates a script device writing its trace to filename.
+func NewScript(filename string) (*Script, error) {
+	script, err := surface.NewScript(filename)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "script")
+	}
+	return script, nil
+}
+
+// NewScriptForWriter creates a script device writing its trace to w.
+// Close the script to finish the trace; Close returns a write error if any
+// write to w failed.
+func NewScriptForWriter(w io.Writer) (*Script, error) {
+	script, err := surface.NewScriptForWriter(w)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "script")
+	}
+	return script, nil
+}
+
+// NewScriptSurface creates a surface of the given content and size whose
+// drawing is recorded into script without being rendered.
+func NewScriptSurface(script *Script, content Content, width, height float64) (*ScriptSurface, error) {
+	surf, err := surface.NewScriptSurface(script, content, width, height)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "script")
+	}
+	return surf, nil
+}
+
+// NewScriptSurfaceForTarget creates a surface that draws onto target and
+// records every call into script. Draw on the returned surface instead of
+// target.
+//
+// Example:
+//
+//	trace, err := cairo.NewScript("render.cs")
+//	if err != nil {
+//	    return err
+//	}
+//	defer trace.Close()
+//
+//	traced, err := cairo.NewScriptSurfaceForTarget(trace, surf)
+//	if err != nil {
+//	    return err
+//	}
+//	defer traced.Close()
+//
+//	ctx, err := cairo.NewContext(traced)
+//	if err != nil {
+//	    return err
+//	}
+//	defer ctx.Close()
+//	render(ctx)
+func NewScriptSurfaceForTarget(script *Script, target Surface) (*ScriptSurface, error) {
+	surf, err := surface.NewScriptSurfaceForTarget(script, target)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "script")
+	}
+	return surf, nil
+}
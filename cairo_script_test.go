@@ -0,0 +1,94 @@
+// ABOUTME: Tests for Script, ScriptSurface and Device through the root cairo package, drawing with a Context.
+// ABOUTME: Covers tracing onto an existing surface, trace-only surfaces and file output.
+
+//go:build !noscript
+
+package cairo_test
+
+import (
+	"bytes"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScriptSurfaceForTargetViaRootPackage verifies that drawing on a traced
+// surface renders onto the target and records the calls.
+func TestScriptSurfaceForTargetViaRootPackage(t *testing.T) {
+	var buf bytes.Buffer
+	trace, err := cairo.NewScriptForWriter(&buf)
+	require.NoError(t, err)
+
+	target, err := cairo.NewImageSurface(cairo.FormatARGB32, 100, 100)
+	require.NoError(t, err)
+	defer func() {
+		_ = target.Close()
+	}()
+
+	traced, err := cairo.NewScriptSurfaceForTarget(trace, target)
+	require.NoError(t, err)
+
+	dev, err := traced.GetDevice()
+	require.NoError(t, err)
+	assert.Equal(t, cairo.DeviceTypeScript, dev.GetType())
+	require.NoError(t, dev.Close())
+
+	ctx, err := cairo.NewContext(traced)
+	require.NoError(t, err)
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(10, 20, 40, 30)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	require.NoError(t, traced.Close())
+	require.NoError(t, trace.Close())
+
+	img, err := target.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(30, 30))
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%!CairoScript")))
+	assert.Contains(t, buf.String(), "rectangle")
+}
+
+// TestNewScriptSurfaceViaRootPackage verifies a trace-only surface writes a
+// trace file.
+func TestNewScriptSurfaceViaRootPackage(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "trace.cs")
+
+	trace, err := cairo.NewScript(filename)
+	require.NoError(t, err)
+	trace.SetMode(cairo.ScriptModeASCII)
+	trace.WriteComment("root package")
+
+	surf, err := cairo.NewScriptSurface(trace, cairo.ContentColorAlpha, 50, 50)
+	require.NoError(t, err)
+
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	ctx.Paint()
+	require.NoError(t, ctx.Close())
+
+	require.NoError(t, surf.Close())
+	require.NoError(t, trace.Close())
+
+	data, err := os.ReadFile(filename) //nolint:gosec // filename is from t.TempDir()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "root package")
+	assert.Contains(t, string(data), "paint")
+}
+
+// TestNewScriptInvalidPathViaRootPackage verifies that an invalid path
+// returns a SurfaceError.
+func TestNewScriptInvalidPathViaRootPackage(t *testing.T) {
+	trace, err := cairo.NewScript("/nonexistent/dir/trace.cs")
+	assert.Nil(t, trace)
+	var surfErr *cairo.SurfaceError
+	require.ErrorAs(t, err, &surfErr)
+	assert.Equal(t, "script", surfErr.SurfaceType)
+}
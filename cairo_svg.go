@@ -1,11 +1,15 @@
-// ABOUTME: Re-exports SVGSurface, SVGUnit, SVGVersion types/constants, and NewSVGSurface/SVGVersions
+// ABOUTME: Re-exports SVGSurface, SVGUnit, SVGVersion types/constants, and NewSVGSurface/NewSVGSurfaceForWriter/SVGVersions
 // ABOUTME: helpers from the surface package for use via the root cairo package.
 
 //go:build !nosvg
 
 package cairo
 
-import "github.com/mikowitz/cairo/surface"
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/surface"
+)
 
 // SVGSurface is a surface that writes drawing operations to an SVG file.
 // Dimensions are specified in points, where 1 point equals 1/72 of an inch.
@@ -79,3 +83,16 @@ func NewSVGSurface(filename string, widthPt, heightPt float64) (*SVGSurface, err
 	}
 	return surf, nil
 }
+
+// NewSVGSurfaceForWriter creates a new SVG surface writing the document to w,
+// such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions in points (1/72 inch).
+// Close the surface to finish the document; Close returns a write error if
+// any write to w failed.
+func NewSVGSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*SVGSurface, error) {
+	surf, err := surface.NewSVGSurfaceForWriter(w, widthPt, heightPt)
+	if err != nil {
+		return nil, wrapSurfaceErr(err, "svg")
+	}
+	return surf, nil
+}
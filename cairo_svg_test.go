@@ -6,6 +6,7 @@
 package cairo_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -115,3 +116,22 @@ func TestSVGVersionConstantsReexport(t *testing.T) {
 	assert.Equal(t, cairo.SVGVersion(0), cairo.SVGVersion11)
 	assert.Equal(t, cairo.SVGVersion(1), cairo.SVGVersion12)
 }
+
+// TestNewSVGSurfaceForWriterViaRootPackage verifies a document drawn through a
+// context is written to an io.Writer when the surface is closed.
+func TestNewSVGSurfaceForWriterViaRootPackage(t *testing.T) {
+	var buf bytes.Buffer
+
+	surf, err := cairo.NewSVGSurfaceForWriter(&buf, 400, 300)
+	require.NoError(t, err)
+
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	ctx.SetSourceRGB(0.0, 1.0, 0.0)
+	ctx.Rectangle(10, 10, 100, 100)
+	ctx.Fill()
+	require.NoError(t, ctx.Close())
+
+	require.NoError(t, surf.Close())
+	assert.Contains(t, buf.String(), "<svg")
+}
@@ -1,6 +1,9 @@
 package cairo_test
 
 import (
+	"bytes"
+	"image"
+	"path/filepath"
 	"testing"
 
 	"github.com/mikowitz/cairo"
@@ -56,6 +59,47 @@ func TestNewImageSurface(t *testing.T) {
 	assert.Equal(t, 100, surface.GetHeight())
 }
 
+// TestNewImageSurfaceFromImageReexport verifies the re-exported constructor
+// converts a standard library image and wraps errors as SurfaceError.
+func TestNewImageSurfaceFromImageReexport(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 30, 20))
+	surf, err := cairo.NewImageSurfaceFromImage(src)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	assert.Equal(t, 30, surf.GetWidth())
+	assert.Equal(t, 20, surf.GetHeight())
+
+	_, err = cairo.NewImageSurfaceForData(nil, cairo.FormatARGB32, 10, 10, 40)
+	var surfErr *cairo.SurfaceError
+	require.ErrorAs(t, err, &surfErr)
+	assert.Equal(t, status.InvalidSize, surfErr.Status)
+}
+
+// TestNewImageSurfaceFromPNGReexport verifies a PNG loaded through the
+// re-exported constructors and load errors wrapped as SurfaceError.
+func TestNewImageSurfaceFromPNGReexport(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "reexport.png")
+	src, err := cairo.NewImageSurface(cairo.FormatARGB32, 12, 8)
+	require.NoError(t, err)
+	require.NoError(t, src.WriteToPNG(filename))
+	require.NoError(t, src.Close())
+
+	surf, err := cairo.NewImageSurfaceFromPNG(filename)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	assert.Equal(t, 12, surf.GetWidth())
+	assert.Equal(t, 8, surf.GetHeight())
+
+	_, err = cairo.NewImageSurfaceFromPNGReader(bytes.NewReader(nil))
+	var surfErr *cairo.SurfaceError
+	require.ErrorAs(t, err, &surfErr)
+	assert.Equal(t, status.ReadError, surfErr.Status)
+}
+
 // TestNewImageSurfaceWithDifferentFormats tests various format constants
 func TestNewImageSurfaceWithDifferentFormats(t *testing.T) {
 	formats := []cairo.Format{
@@ -0,0 +1,38 @@
+// ABOUTME: Re-exports user font faces, whose glyphs are drawn by Go callbacks, from the userfont package.
+// ABOUTME: Enables creating icon and pictogram fonts through the root cairo package.
+
+package cairo
+
+import "github.com/mikowitz/cairo/font/userfont"
+
+// UserFontFuncs holds the callbacks that implement a user font. Only
+// RenderGlyph is required. See the userfont package for details.
+type UserFontFuncs = userfont.Funcs
+
+// NewUserFontFace creates a font face whose glyphs are drawn by the Go
+// callbacks in funcs. Select the face with Context.SetFontFace.
+//
+// The returned face must be closed with Close() when finished to release
+// Cairo resources.
+//
+// Example:
+//
+//	face, err := cairo.NewUserFontFace(cairo.UserFontFuncs{
+//		RenderGlyph: func(_ *cairo.ScaledFont, _ uint64, ctx *cairo.Context, ext *cairo.TextExtents) error {
+//			ctx.Rectangle(0.1, -0.9, 0.8, 0.8)
+//			ctx.Fill()
+//			ext.XAdvance = 1
+//			return nil
+//		},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+func NewUserFontFace(funcs UserFontFuncs) (*FontFace, error) {
+	face, err := userfont.NewFace(funcs)
+	if err != nil {
+		return nil, wrapFontErr(err, "user")
+	}
+	return face, nil
+}
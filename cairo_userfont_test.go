@@ -0,0 +1,62 @@
+// ABOUTME: Tests for the user font face constructor re-exported from the root cairo package.
+// ABOUTME: Covers drawing text with a user font and FontError wrapping for missing callbacks.
+
+package cairo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikowitz/cairo"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewUserFontFace verifies text can be drawn with a user font face.
+func TestNewUserFontFace(t *testing.T) {
+	face, err := cairo.NewUserFontFace(cairo.UserFontFuncs{
+		RenderGlyph: func(_ *cairo.ScaledFont, _ uint64, ctx *cairo.Context, ext *cairo.TextExtents) error {
+			ctx.Rectangle(0, -1, 1, 1)
+			ctx.Fill()
+			ext.XAdvance = 1
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+	assert.Equal(t, font.FontTypeUser, face.GetType())
+
+	surf, err := cairo.NewImageSurface(cairo.FormatARGB32, 40, 20)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := cairo.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	ctx.SetFontFace(face)
+	ctx.SetFontSize(10)
+	ctx.MoveTo(0, 15)
+	ctx.ShowText("abc")
+	assert.Equal(t, status.Success, ctx.Status())
+	assert.InDelta(t, 30.0, ctx.TextExtents("abc").XAdvance, 1e-9)
+}
+
+// TestNewUserFontFaceError verifies a missing RenderGlyph callback is
+// reported as a *FontError wrapping status.NullPointer.
+func TestNewUserFontFaceError(t *testing.T) {
+	face, err := cairo.NewUserFontFace(cairo.UserFontFuncs{})
+	assert.Nil(t, face)
+
+	var fe *cairo.FontError
+	require.True(t, errors.As(err, &fe))
+	assert.Equal(t, "user", fe.FontType)
+	assert.True(t, errors.Is(err, status.NullPointer))
+}
@@ -35,6 +35,23 @@ func NewContext(surface surface.Surface) (*Context, error) {
 	return c, nil
 }
 
+// ContextFromC wraps a C cairo_t pointer.
+//
+// This function is primarily used internally when Cairo hands a context to
+// a callback, such as the render callback of a user font. The returned
+// Context takes ownership of one reference to the C context and releases it
+// when Close() is called or when the finalizer runs, so callers must
+// reference a borrowed pointer before wrapping it.
+func ContextFromC(ptr unsafe.Pointer) *Context {
+	c := &Context{
+		ptr: ContextPtr(ptr),
+	}
+
+	runtime.SetFinalizer(c, (*Context).close)
+
+	return c
+}
+
 // Status checks whether an error has previously occurred for this context.
 func (c *Context) Status() status.Status {
 	c.RLock()
@@ -47,6 +64,26 @@ func (c *Context) Status() status.Status {
 	return contextStatus(c.ptr)
 }
 
+// WithPtr calls fn with the underlying C cairo_t pointer while holding the
+// context's lock, so that bindings to libraries that draw with Cairo, such as
+// Pango, cannot race with other users of the context or with Close.
+//
+// WithPtr is unsafe: fn must not keep ptr after it returns, and must not call
+// methods on the context, which would deadlock.
+//
+// Returns status.NullPointer without calling fn if the context has been
+// closed.
+func (c *Context) WithPtr(fn func(ptr unsafe.Pointer)) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.ptr == nil {
+		return status.NullPointer
+	}
+	fn(unsafe.Pointer(c.ptr)) //nolint:gosec
+	return nil
+}
+
 func (c *Context) Close() error {
 	return c.close()
 }
@@ -378,6 +415,24 @@ func (c *Context) Paint() {
 	})
 }
 
+// PaintWithAlpha paints the current source everywhere within the current
+// clip region, like [Context.Paint], but using a mask of constant alpha.
+// An alpha of 0 paints nothing and an alpha of 1 is equivalent to Paint.
+//
+// Combined with groups, PaintWithAlpha composites a whole layer at a given
+// opacity; see [Context.WithOpacity].
+//
+// Example:
+//
+//	// Paint a background image at half opacity
+//	ctx.SetSource(imagePattern)
+//	ctx.PaintWithAlpha(0.5)
+func (c *Context) PaintWithAlpha(alpha float64) {
+	c.withLock(func() {
+		contextPaintWithAlpha(c.ptr, alpha)
+	})
+}
+
 // SetLineWidth sets the current line width for the Context. The line width
 // value specifies the diameter of the pen used for stroking paths, in user-space
 // units.
@@ -12,6 +12,7 @@ import (
 	"github.com/mikowitz/cairo/matrix"
 	"github.com/mikowitz/cairo/pattern"
 	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
 )
 
 type ContextPtr *C.cairo_t
@@ -151,6 +152,10 @@ func contextPaint(ptr ContextPtr) {
 	C.cairo_paint(ptr)
 }
 
+func contextPaintWithAlpha(ptr ContextPtr, alpha float64) {
+	C.cairo_paint_with_alpha(ptr, C.double(alpha))
+}
+
 func contextSetLineWidth(ptr ContextPtr, width float64) {
 	C.cairo_set_line_width(ptr, C.double(width))
 }
@@ -317,6 +322,29 @@ func contextClipExtents(ptr ContextPtr) (float64, float64, float64, float64) {
 	return float64(x1), float64(y1), float64(x2), float64(y2)
 }
 
+func contextCopyClipRectangleList(ptr ContextPtr) ([]Rect, status.Status) {
+	list := C.cairo_copy_clip_rectangle_list(ptr)
+	defer C.cairo_rectangle_list_destroy(list)
+
+	if st := status.Status(list.status); st != status.Success {
+		return nil, st
+	}
+
+	rects := make([]Rect, int(list.num_rectangles))
+	if len(rects) == 0 {
+		return rects, status.Success
+	}
+	for i, r := range unsafe.Slice(list.rectangles, len(rects)) {
+		rects[i] = Rect{
+			X:      float64(r.x),
+			Y:      float64(r.y),
+			Width:  float64(r.width),
+			Height: float64(r.height),
+		}
+	}
+	return rects, status.Success
+}
+
 func contextSetOperator(ptr ContextPtr, op Operator) {
 	C.cairo_set_operator(ptr, C.cairo_operator_t(op))
 }
@@ -407,3 +435,234 @@ func contextFontExtents(ptr ContextPtr) font.FontExtents {
 		MaxYAdvance: float64(extents.max_y_advance),
 	}
 }
+
+func contextSetFontFace(ptr ContextPtr, facePtr unsafe.Pointer) {
+	C.cairo_set_font_face(ptr, (*C.cairo_font_face_t)(facePtr))
+}
+
+func contextGetFontFace(ptr ContextPtr) (*font.Face, error) {
+	facePtr := C.cairo_get_font_face(ptr)
+	if st := status.Status(C.cairo_font_face_status(facePtr)); st != status.Success {
+		return nil, st
+	}
+	C.cairo_font_face_reference(facePtr)
+	return font.FaceFromC(unsafe.Pointer(facePtr)), nil
+}
+
+func contextSetFontOptions(ptr ContextPtr, optsPtr unsafe.Pointer) {
+	C.cairo_set_font_options(ptr, (*C.cairo_font_options_t)(optsPtr))
+}
+
+func contextGetFontOptions(ptr ContextPtr) (*font.Options, error) {
+	options := C.cairo_font_options_create()
+	if st := status.Status(C.cairo_font_options_status(options)); st != status.Success {
+		C.cairo_font_options_destroy(options)
+		return nil, st
+	}
+	C.cairo_get_font_options(ptr, options)
+	return font.OptionsFromC(unsafe.Pointer(options)), nil
+}
+
+func contextSetScaledFont(ptr ContextPtr, sfPtr unsafe.Pointer) {
+	C.cairo_set_scaled_font(ptr, (*C.cairo_scaled_font_t)(sfPtr))
+}
+
+func contextGetScaledFont(ptr ContextPtr) (*font.ScaledFont, error) {
+	sfPtr := C.cairo_get_scaled_font(ptr)
+	if st := status.Status(C.cairo_scaled_font_status(sfPtr)); st != status.Success {
+		return nil, st
+	}
+	C.cairo_scaled_font_reference(sfPtr)
+	return font.ScaledFontFromC(unsafe.Pointer(sfPtr)), nil
+}
+
+// glyphsToC converts glyphs to cairo_glyph_t values. The result is Go
+// memory holding no Go pointers, so it may be passed to Cairo directly.
+func glyphsToC(glyphs []font.Glyph) []C.cairo_glyph_t {
+	cGlyphs := make([]C.cairo_glyph_t, len(glyphs))
+	for i, g := range glyphs {
+		cGlyphs[i] = C.cairo_glyph_t{
+			index: C.ulong(g.Index),
+			x:     C.double(g.X),
+			y:     C.double(g.Y),
+		}
+	}
+	return cGlyphs
+}
+
+func contextShowGlyphs(ptr ContextPtr, glyphs []font.Glyph) {
+	cGlyphs := glyphsToC(glyphs)
+	C.cairo_show_glyphs(ptr, &cGlyphs[0], C.int(len(cGlyphs)))
+}
+
+func contextShowTextGlyphs(ptr ContextPtr, text string, glyphs []font.Glyph, clusters []font.TextCluster, flags font.TextClusterFlags) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var glyphsPtr *C.cairo_glyph_t
+	if len(glyphs) > 0 {
+		cGlyphs := glyphsToC(glyphs)
+		glyphsPtr = &cGlyphs[0]
+	}
+	var clustersPtr *C.cairo_text_cluster_t
+	if len(clusters) > 0 {
+		cClusters := make([]C.cairo_text_cluster_t, len(clusters))
+		for i, c := range clusters {
+			cClusters[i] = C.cairo_text_cluster_t{
+				num_bytes:  C.int(c.NumBytes),
+				num_glyphs: C.int(c.NumGlyphs),
+			}
+		}
+		clustersPtr = &cClusters[0]
+	}
+
+	C.cairo_show_text_glyphs(
+		ptr,
+		cText, C.int(len(text)),
+		glyphsPtr, C.int(len(glyphs)),
+		clustersPtr, C.int(len(clusters)),
+		C.cairo_text_cluster_flags_t(flags),
+	)
+}
+
+func contextGlyphPath(ptr ContextPtr, glyphs []font.Glyph) {
+	cGlyphs := glyphsToC(glyphs)
+	C.cairo_glyph_path(ptr, &cGlyphs[0], C.int(len(cGlyphs)))
+}
+
+func contextGlyphExtents(ptr ContextPtr, glyphs []font.Glyph) font.TextExtents {
+	cGlyphs := glyphsToC(glyphs)
+	var extents C.cairo_text_extents_t
+	C.cairo_glyph_extents(ptr, &cGlyphs[0], C.int(len(cGlyphs)), &extents)
+	return font.TextExtents{
+		XBearing: float64(extents.x_bearing),
+		YBearing: float64(extents.y_bearing),
+		Width:    float64(extents.width),
+		Height:   float64(extents.height),
+		XAdvance: float64(extents.x_advance),
+		YAdvance: float64(extents.y_advance),
+	}
+}
+
+// pathDataHeader and pathDataPoint mirror the two members of the
+// cairo_path_data_t union, which cgo exposes only as raw bytes.
+type pathDataHeader struct {
+	typ    C.cairo_path_data_type_t
+	length C.int
+}
+
+type pathDataPoint struct {
+	x, y C.double
+}
+
+func contextCopyPath(ptr ContextPtr, flat bool) (*Path, error) {
+	var cPath *C.cairo_path_t
+	if flat {
+		cPath = C.cairo_copy_path_flat(ptr)
+	} else {
+		cPath = C.cairo_copy_path(ptr)
+	}
+	defer C.cairo_path_destroy(cPath)
+
+	if st := status.Status(cPath.status); st != status.Success {
+		return nil, st
+	}
+
+	path := &Path{}
+	if cPath.num_data == 0 {
+		return path, nil
+	}
+
+	data := unsafe.Slice(cPath.data, int(cPath.num_data))
+	for i := 0; i < len(data); {
+		header := (*pathDataHeader)(unsafe.Pointer(&data[i]))
+		length := int(header.length)
+
+		points := make([]PathPoint, 0, length-1)
+		for j := 1; j < length; j++ {
+			pt := (*pathDataPoint)(unsafe.Pointer(&data[i+j]))
+			points = append(points, PathPoint{X: float64(pt.x), Y: float64(pt.y)})
+		}
+		path.Segments = append(path.Segments, PathSegment{
+			Type:   PathSegmentType(header.typ),
+			Points: points,
+		})
+
+		i += length
+	}
+	return path, nil
+}
+
+// contextAppendPath copies p into C memory, since cairo_path_t must not
+// point at Go memory, and appends it to the current path. p must already be
+// validated.
+func contextAppendPath(ptr ContextPtr, p *Path) {
+	n := 0
+	for _, seg := range p.Segments {
+		n += 1 + len(seg.Points)
+	}
+	if n == 0 {
+		return
+	}
+
+	var elem C.cairo_path_data_t
+	cData := (*C.cairo_path_data_t)(C.calloc(C.size_t(n), C.size_t(unsafe.Sizeof(elem))))
+	defer C.free(unsafe.Pointer(cData))
+
+	data := unsafe.Slice(cData, n)
+	i := 0
+	for _, seg := range p.Segments {
+		header := (*pathDataHeader)(unsafe.Pointer(&data[i]))
+		header.typ = C.cairo_path_data_type_t(seg.Type)
+		header.length = C.int(1 + len(seg.Points))
+		i++
+
+		for _, pt := range seg.Points {
+			point := (*pathDataPoint)(unsafe.Pointer(&data[i]))
+			point.x, point.y = C.double(pt.X), C.double(pt.Y)
+			i++
+		}
+	}
+
+	cPath := C.cairo_path_t{
+		status:   C.CAIRO_STATUS_SUCCESS,
+		data:     cData,
+		num_data: C.int(n),
+	}
+	C.cairo_append_path(ptr, &cPath)
+}
+
+func contextPushGroup(ptr ContextPtr) {
+	C.cairo_push_group(ptr)
+}
+
+func contextPushGroupWithContent(ptr ContextPtr, content surface.Content) {
+	C.cairo_push_group_with_content(ptr, C.cairo_content_t(content))
+}
+
+func contextPopGroup(ptr ContextPtr) (pattern.Pattern, error) {
+	patternPtr := C.cairo_pop_group(ptr)
+	if st := status.Status(C.cairo_pattern_status(patternPtr)); st != status.Success {
+		C.cairo_pattern_destroy(patternPtr)
+		return nil, st
+	}
+	return pattern.PatternFromC(unsafe.Pointer(patternPtr)), nil
+}
+
+func contextPopGroupToSource(ptr ContextPtr) {
+	C.cairo_pop_group_to_source(ptr)
+}
+
+func contextGetGroupTarget(ptr ContextPtr) surface.Surface {
+	surfacePtr := C.cairo_get_group_target(ptr)
+	C.cairo_surface_reference(surfacePtr)
+	return surface.SurfaceFromC(unsafe.Pointer(surfacePtr))
+}
+
+func contextMask(ptr ContextPtr, patternPtr unsafe.Pointer) {
+	C.cairo_mask(ptr, (*C.cairo_pattern_t)(patternPtr))
+}
+
+func contextMaskSurface(ptr ContextPtr, surfacePtr unsafe.Pointer, x, y float64) {
+	C.cairo_mask_surface(ptr, (*C.cairo_surface_t)(surfacePtr), C.double(x), C.double(y))
+}
@@ -1,7 +1,15 @@
 // ABOUTME: Clipping operations for Cairo drawing contexts.
-// ABOUTME: Provides clip, reset, extents, and point-in-clip testing in user coordinates.
+// ABOUTME: Provides clip, reset, extents, rectangle lists, and point-in-clip testing in user coordinates.
 package context
 
+import "github.com/mikowitz/cairo/status"
+
+// Rect is a rectangle in user-space coordinates, given by its top-left
+// corner and its size.
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
 // Clip establishes a new clip region by intersecting the current path with the
 // existing clip region. After Clip, the current path is cleared.
 //
@@ -135,3 +143,46 @@ func (c *Context) InClip(x, y float64) bool {
 
 	return contextInClip(c.ptr, x, y)
 }
+
+// ClipRectangles returns the current clip region as a list of rectangles in
+// user-space coordinates. A context without a clip returns a single
+// rectangle covering the surface, and a clip that excludes everything
+// returns an empty list.
+//
+// Only clips built from pixel-aligned rectangles can be listed. Curved
+// clips, rectangles that do not fall on device pixels, rectangles whose
+// edges are not aligned with the axes of user space (for example after
+// Rotate) and the unclipped area of an unbounded recording surface cannot,
+// and return status.ClipNotRepresentable. ClipExtents and InClip work for
+// every clip.
+//
+// Returns status.ClipNotRepresentable as described above, the status of the
+// context if it is in an error state, or status.NullPointer if the context
+// has been closed.
+//
+// Example:
+//
+//	rects, err := ctx.ClipRectangles()
+//	if errors.Is(err, status.ClipNotRepresentable) {
+//		x1, y1, x2, y2 := ctx.ClipExtents()
+//		rects = []context.Rect{{X: x1, Y: y1, Width: x2 - x1, Height: y2 - y1}}
+//	} else if err != nil {
+//		return err
+//	}
+//	for _, r := range rects {
+//		redrawTilesIn(r)
+//	}
+func (c *Context) ClipRectangles() ([]Rect, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+
+	rects, st := contextCopyClipRectangleList(c.ptr)
+	if st != status.Success {
+		return nil, st
+	}
+	return rects, nil
+}
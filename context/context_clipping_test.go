@@ -388,6 +388,83 @@ func TestContextClipWithTransform(t *testing.T) {
 	})
 }
 
+// TestContextClipRectangles verifies listing rectangular clips and
+// rejecting clips that are not rectangles.
+func TestContextClipRectangles(t *testing.T) {
+	t.Run("NoClip", func(t *testing.T) {
+		ctx := newTestContext(t, 100, 80)
+		rects, err := ctx.ClipRectangles()
+		require.NoError(t, err)
+		assert.Equal(t, []Rect{{X: 0, Y: 0, Width: 100, Height: 80}}, rects)
+	})
+
+	t.Run("DisjointRectangles", func(t *testing.T) {
+		ctx := newTestContext(t, 100, 100)
+		ctx.Rectangle(10, 10, 20, 20)
+		ctx.Rectangle(60, 50, 30, 10)
+		ctx.Clip()
+
+		rects, err := ctx.ClipRectangles()
+		require.NoError(t, err)
+		assert.Equal(t, []Rect{
+			{X: 10, Y: 10, Width: 20, Height: 20},
+			{X: 60, Y: 50, Width: 30, Height: 10},
+		}, rects)
+	})
+
+	t.Run("UserSpace", func(t *testing.T) {
+		ctx := newTestContext(t, 100, 100)
+		ctx.Rectangle(20, 20, 40, 40)
+		ctx.Clip()
+		ctx.Translate(10, 10)
+		ctx.Scale(2, 2)
+
+		rects, err := ctx.ClipRectangles()
+		require.NoError(t, err)
+		assert.Equal(t, []Rect{{X: 5, Y: 5, Width: 20, Height: 20}}, rects)
+	})
+
+	t.Run("ClippedOut", func(t *testing.T) {
+		ctx := newTestContext(t, 100, 100)
+		ctx.Rectangle(0, 0, 10, 10)
+		ctx.Clip()
+		ctx.Rectangle(50, 50, 10, 10)
+		ctx.Clip()
+
+		rects, err := ctx.ClipRectangles()
+		require.NoError(t, err)
+		assert.Empty(t, rects)
+	})
+
+	notRepresentable := []struct {
+		name string
+		clip func(ctx *Context)
+	}{
+		{"Circle", func(ctx *Context) {
+			ctx.Arc(50, 50, 20, 0, 2*math.Pi)
+		}},
+		{"FractionalRectangle", func(ctx *Context) {
+			ctx.Rectangle(10.5, 10, 20, 20)
+		}},
+		{"RotatedRectangle", func(ctx *Context) {
+			ctx.Rotate(math.Pi / 6)
+			ctx.Rectangle(30, 10, 20, 20)
+		}},
+	}
+	for _, tt := range notRepresentable {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t, 100, 100)
+			tt.clip(ctx)
+			ctx.Clip()
+
+			rects, err := ctx.ClipRectangles()
+			assert.Equal(t, status.ClipNotRepresentable, err)
+			assert.Nil(t, rects)
+			assert.Equal(t, status.Success, ctx.Status(), "the context should not be put in an error state")
+		})
+	}
+}
+
 // TestContextClipAfterClose verifies safe behavior after context close.
 func TestContextClipAfterClose(t *testing.T) {
 	surf, err := surface.NewImageSurface(surface.FormatARGB32, 100, 100)
@@ -433,4 +510,10 @@ func TestContextClipAfterClose(t *testing.T) {
 		ctx.ResetClip()
 		// Should be safe
 	})
+
+	t.Run("ClipRectanglesAfterClose", func(t *testing.T) {
+		rects, err := ctx.ClipRectangles()
+		assert.Equal(t, status.NullPointer, err)
+		assert.Nil(t, rects)
+	})
 }
@@ -2,7 +2,9 @@ package context
 
 import (
 	"runtime"
+	"sync"
 	"testing"
+	"unsafe"
 
 	"github.com/mikowitz/cairo/pattern"
 	"github.com/mikowitz/cairo/status"
@@ -57,6 +59,28 @@ func TestContextClose(t *testing.T) {
 	assert.Equal(t, status.NullPointer, st, "Status after close should be NullPointer")
 }
 
+// TestContextWithPtr verifies that WithPtr passes the C pointer while holding
+// the context's lock, and does not call fn once the context is closed.
+func TestContextWithPtr(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	var got unsafe.Pointer
+	err := ctx.WithPtr(func(ptr unsafe.Pointer) {
+		got = ptr
+		assert.False(t, ctx.TryRLock(), "the context should be locked during fn")
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, got, "fn should receive the C pointer")
+
+	require.NoError(t, ctx.Close())
+	called := false
+	err = ctx.WithPtr(func(unsafe.Pointer) {
+		called = true
+	})
+	assert.Equal(t, status.NullPointer, err)
+	assert.False(t, called, "fn should not be called after close")
+}
+
 // TestContextStatus verifies that Status returns the correct status.
 func TestContextStatus(t *testing.T) {
 	ctx := newTestContext(t, 100, 100)
@@ -600,6 +624,36 @@ func TestContextPaint(t *testing.T) {
 	assert.Equal(t, status.Success, st, "Status should be Success after Paint with alpha")
 }
 
+// TestContextPaintWithAlpha verifies that PaintWithAlpha scales the source's
+// coverage by a constant alpha.
+func TestContextPaintWithAlpha(t *testing.T) {
+	testCases := []struct {
+		name  string
+		alpha float64
+		want  uint8
+	}{
+		{"Transparent", 0.0, 0x00},
+		{"Half", 0.5, 0x80},
+		{"Opaque", 1.0, 0xff},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, surf := newTestContextWithSurface(t, 10, 10)
+
+			ctx.SetSourceRGB(1, 0, 0)
+			ctx.PaintWithAlpha(tc.alpha)
+			require.Equal(t, status.Success, ctx.Status())
+
+			px := pixelAt(t, surf, 5, 5)
+			assert.InDelta(t, tc.want, px.R, 1)
+			assert.InDelta(t, tc.want, px.A, 1)
+			assert.Zero(t, px.G)
+			assert.Zero(t, px.B)
+		})
+	}
+}
+
 // TestContextSetLineWidth verifies that SetLineWidth sets the line width for stroking.
 func TestContextSetLineWidth(t *testing.T) {
 	ctx := newTestContext(t, 200, 200)
@@ -940,6 +994,56 @@ func TestContextSetSourcePatternAfterClose(t *testing.T) {
 	assert.Equal(t, status.NullPointer, st, "Status after close should be NullPointer")
 }
 
+// TestContextSetSourceConicGradientConcurrent verifies a context can take a
+// conic gradient as its source while another goroutine adds stops, which
+// replaces the gradient's mesh.
+// Run with: go test -race
+func TestContextSetSourceConicGradientConcurrent(t *testing.T) {
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, 20, 20)
+	require.NoError(t, err, "Failed to create surface")
+	defer func() {
+		err := surf.Close()
+		assert.NoError(t, err, "Failed to close surface")
+	}()
+
+	ctx, err := NewContext(surf)
+	require.NoError(t, err, "Failed to create context")
+	defer func() {
+		err := ctx.Close()
+		assert.NoError(t, err, "Failed to close context")
+	}()
+
+	cg, err := pattern.NewConicGradient(10, 10, 0)
+	require.NoError(t, err)
+
+	const iterations = 50
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := range iterations {
+			cg.AddColorStopRGBA(float64(i)/iterations, 1, 0, 0, 1)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for range iterations {
+			ctx.SetSource(cg)
+			ctx.Paint()
+		}
+	}()
+
+	wg.Wait()
+
+	// The context's source must outlive the gradient it came from.
+	require.NoError(t, cg.Close())
+	ctx.Paint()
+	assert.Equal(t, status.Success, ctx.Status())
+}
+
 // TestContextGetSourceAfterClose verifies that GetSource returns an error after close.
 func TestContextGetSourceAfterClose(t *testing.T) {
 	surf, err := surface.NewImageSurface(surface.FormatARGB32, 100, 100)
@@ -1,9 +1,10 @@
 // ABOUTME: Shared test helpers for context package tests.
-// ABOUTME: Provides newTestContext for creating a Context and ImageSurface with automatic cleanup.
+// ABOUTME: Provides Context/ImageSurface constructors with automatic cleanup and a pixel reader.
 
 package context
 
 import (
+	"image/color"
 	"testing"
 
 	"github.com/mikowitz/cairo/surface"
@@ -24,3 +25,26 @@ func newTestContext(t *testing.T, width, height int) *Context {
 	})
 	return ctx
 }
+
+// newTestContextWithSurface is like newTestContext but also returns the
+// ImageSurface, for tests that inspect rendered pixels.
+func newTestContextWithSurface(t *testing.T, width, height int) (*Context, *surface.ImageSurface) {
+	t.Helper()
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, width, height)
+	require.NoError(t, err)
+	ctx, err := NewContext(surf)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ctx.Close()
+		_ = surf.Close()
+	})
+	return ctx, surf
+}
+
+// pixelAt returns the premultiplied color of the pixel at (x, y) on surf.
+func pixelAt(t *testing.T, surf *surface.ImageSurface, x, y int) color.RGBA {
+	t.Helper()
+	img, err := surf.Image()
+	require.NoError(t, err)
+	return img.At(x, y).(color.RGBA)
+}
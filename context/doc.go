@@ -28,6 +28,7 @@
 //  2. Active Use: During this phase, you can:
 //     - Set drawing parameters (colors, line width, transformations, etc.)
 //     - Build paths (MoveTo, LineTo, Rectangle, Arc, etc.)
+//     - Copy and replay paths (CopyPath, CopyPathFlat, AppendPath)
 //     - Render paths (Fill, Stroke, Paint)
 //     - Manage graphics state via Save/Restore
 //     All operations are thread-safe due to internal locking.
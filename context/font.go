@@ -1,9 +1,12 @@
-// ABOUTME: Implements the toy font API methods on Context.
-// ABOUTME: Provides SelectFontFace, SetFontSize, ShowText, and TextPath.
+// ABOUTME: Implements the toy font API and glyph-level text methods on Context.
+// ABOUTME: Provides SelectFontFace, font faces, SetFontSize, ShowText, TextPath, font options, scaled fonts, ShowGlyphs, ShowTextGlyphs, and GlyphPath.
 
 package context
 
-import "github.com/mikowitz/cairo/font"
+import (
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+)
 
 // SelectFontFace selects a font face for the context using a font family name,
 // slant, and weight. This is part of Cairo's toy font API, which provides a
@@ -24,6 +27,52 @@ func (c *Context) SelectFontFace(family string, slant font.Slant, weight font.We
 	})
 }
 
+// SetFontFace replaces the current font face of the context with face, such
+// as one loaded with font.NewFaceFromFile or font.NewFaceFromBytes. The
+// font size and matrix are unchanged. The context keeps its own reference,
+// so face may be closed afterwards.
+//
+// If face is nil or has been closed, SetFontFace has no effect.
+//
+// Example:
+//
+//	face, err := font.NewFaceFromFile("fonts/Inter-Regular.ttf", 0)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+//
+//	ctx.SetFontFace(face)
+//	ctx.SetFontSize(16)
+//	ctx.ShowText("Reproducible text")
+func (c *Context) SetFontFace(face *font.Face) {
+	if face == nil {
+		return
+	}
+	facePtr := face.Ptr()
+	if facePtr == nil {
+		return
+	}
+
+	c.withLock(func() {
+		contextSetFontFace(c.ptr, facePtr)
+	})
+}
+
+// GetFontFace returns the current font face of the context. The returned
+// Face holds its own reference and should be closed by the caller.
+//
+// Returns status.NullPointer if the context has been closed.
+func (c *Context) GetFontFace() (*font.Face, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return contextGetFontFace(c.ptr)
+}
+
 // SetFontSize sets the current font size for text rendering, specified in
 // user-space units. The default font size is 10.
 //
@@ -73,3 +122,189 @@ func (c *Context) TextPath(text string) {
 		contextTextPath(c.ptr, text)
 	})
 }
+
+// SetFontOptions sets the font options used for rendering text with the
+// context. They are merged with the font options of the target surface:
+// options left at their Default value are taken from the surface. The
+// options are copied, so opts may be changed or closed afterwards.
+//
+// If opts is nil or has been closed, SetFontOptions has no effect.
+//
+// Example:
+//
+//	// Crisp, fully hinted text for small raster thumbnails
+//	opts, _ := font.NewOptions()
+//	defer opts.Close()
+//	opts.SetHintStyle(font.HintStyleFull)
+//	opts.SetHintMetrics(font.HintMetricsOn)
+//	ctx.SetFontOptions(opts)
+func (c *Context) SetFontOptions(opts *font.Options) {
+	if opts == nil {
+		return
+	}
+	optsPtr := opts.Ptr()
+	if optsPtr == nil {
+		return
+	}
+
+	c.withLock(func() {
+		contextSetFontOptions(c.ptr, optsPtr)
+	})
+}
+
+// GetFontOptions returns a copy of the font options set with
+// [Context.SetFontOptions]. The surface's options are not included. The
+// returned Options should be closed by the caller.
+//
+// Returns status.NullPointer if the context has been closed.
+func (c *Context) GetFontOptions() (*font.Options, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return contextGetFontOptions(c.ptr)
+}
+
+// SetScaledFont replaces the current font face, font matrix and font
+// options of the context with those of sf. Unlike [Context.SetFontSize],
+// the CTM of the context is not changed; text drawn with the context uses
+// the context's own CTM.
+//
+// If sf is nil or has been closed, SetScaledFont has no effect.
+//
+// Example:
+//
+//	sf, _ := font.NewScaledFont(face, matrix.NewScalingMatrix(18, 18), matrix.NewIdentityMatrix())
+//	defer sf.Close()
+//	ctx.SetScaledFont(sf)
+func (c *Context) SetScaledFont(sf *font.ScaledFont) {
+	if sf == nil {
+		return
+	}
+	sfPtr := sf.Ptr()
+	if sfPtr == nil {
+		return
+	}
+
+	c.withLock(func() {
+		contextSetScaledFont(c.ptr, sfPtr)
+	})
+}
+
+// GetScaledFont returns the scaled font for the context's current font face,
+// font matrix, CTM and font options. Use it to convert text to glyphs with
+// [font.ScaledFont.TextToGlyphs] before drawing them with
+// [Context.ShowGlyphs]. The returned ScaledFont holds its own reference and
+// should be closed by the caller.
+//
+// Returns status.NullPointer if the context has been closed.
+//
+// Example:
+//
+//	sf, err := ctx.GetScaledFont()
+//	if err != nil {
+//	    return err
+//	}
+//	defer sf.Close()
+//	glyphs, err := sf.TextToGlyphs(10, 50, "Kerned")
+func (c *Context) GetScaledFont() (*font.ScaledFont, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return contextGetScaledFont(c.ptr)
+}
+
+// ShowGlyphs renders glyphs at their given positions using the current font
+// face, font size and source. Glyph positions are in user-space coordinates
+// and the current point is not used or changed.
+//
+// ShowGlyphs gives per-glyph control over placement for kerning tweaks,
+// justified text and text on a path. Obtain glyphs for a string with
+// [Context.GetScaledFont] and [font.ScaledFont.TextToGlyphs].
+//
+// Example:
+//
+//	glyphs, _ := sf.TextToGlyphs(10, 50, "Justify")
+//	for i := range glyphs {
+//	    glyphs[i].X += float64(i) * extraSpace
+//	}
+//	ctx.ShowGlyphs(glyphs)
+func (c *Context) ShowGlyphs(glyphs []font.Glyph) {
+	if len(glyphs) == 0 {
+		return
+	}
+
+	c.withLock(func() {
+		contextShowGlyphs(c.ptr, glyphs)
+	})
+}
+
+// ShowTextGlyphs renders glyphs like [Context.ShowGlyphs] and records the
+// UTF-8 text they represent. clusters map runs of bytes in text to runs of
+// glyphs, as described by flags. Surfaces that support it, such as PDF
+// surfaces, embed the mapping so that the text can be searched, selected
+// and copied; other surfaces just show the glyphs. Use
+// [surface.BaseSurface.HasShowTextGlyphs] to check a surface.
+//
+// Obtain matching glyphs and clusters with
+// [font.ScaledFont.TextToGlyphsWithClusters]. Glyphs may be repositioned
+// freely without changing the clusters.
+//
+// The clusters are checked with [font.ValidateTextClusters] before drawing.
+// Returns status.InvalidString or status.InvalidClusters if they do not
+// match text and glyphs, in which case nothing is drawn, or
+// status.NullPointer if the context has been closed. Errors while drawing
+// are reported by [Context.Status].
+//
+// Example:
+//
+//	sf, _ := ctx.GetScaledFont()
+//	defer sf.Close()
+//	glyphs, clusters, flags, err := sf.TextToGlyphsWithClusters(72, 100, "Total: $120.00")
+//	if err != nil {
+//	    return err
+//	}
+//	if err := ctx.ShowTextGlyphs("Total: $120.00", glyphs, clusters, flags); err != nil {
+//	    return err
+//	}
+func (c *Context) ShowTextGlyphs(text string, glyphs []font.Glyph, clusters []font.TextCluster, flags font.TextClusterFlags) error {
+	if err := font.ValidateTextClusters(text, len(glyphs), clusters); err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.ptr == nil {
+		return status.NullPointer
+	}
+	if text == "" && len(glyphs) == 0 {
+		return nil
+	}
+	contextShowTextGlyphs(c.ptr, text, glyphs, clusters, flags)
+	return nil
+}
+
+// GlyphPath appends the outlines of glyphs to the current path, at their
+// given positions in user-space coordinates. Like [Context.TextPath], it
+// does not render anything; call [Context.Fill] or [Context.Stroke] to paint
+// the outlines.
+//
+// Example:
+//
+//	ctx.GlyphPath(glyphs)
+//	ctx.Stroke()
+func (c *Context) GlyphPath(glyphs []font.Glyph) {
+	if len(glyphs) == 0 {
+		return
+	}
+
+	c.withLock(func() {
+		contextGlyphPath(c.ptr, glyphs)
+	})
+}
@@ -1,4 +1,4 @@
-// ABOUTME: TextExtents and FontExtents methods on Context for text measurement.
+// ABOUTME: TextExtents, GlyphExtents, and FontExtents methods on Context for text measurement.
 // ABOUTME: These return metrics for text layout, alignment, and bounding box drawing.
 
 package context
@@ -27,6 +27,22 @@ func (c *Context) TextExtents(text string) font.TextExtents {
 	return contextTextExtents(c.ptr, text)
 }
 
+// GlyphExtents measures glyphs positioned as they would be drawn by
+// [Context.ShowGlyphs], using the current font face and size. The returned
+// [font.TextExtents] are in user-space coordinates.
+//
+// If glyphs is empty or the context has been closed, GlyphExtents returns a
+// zero-value [font.TextExtents].
+func (c *Context) GlyphExtents(glyphs []font.Glyph) font.TextExtents {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil || len(glyphs) == 0 {
+		return font.TextExtents{}
+	}
+	return contextGlyphExtents(c.ptr, glyphs)
+}
+
 // FontExtents returns the metrics of the current font face at the current font size.
 // The returned [font.FontExtents] describe font-wide dimensions used for line spacing
 // and baseline alignment in multi-line text layouts.
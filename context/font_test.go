@@ -1,5 +1,5 @@
-// ABOUTME: Tests for the toy font API methods on Context.
-// ABOUTME: Covers SelectFontFace, SetFontSize, ShowText, and TextPath.
+// ABOUTME: Tests for the toy font API and glyph-level text methods on Context.
+// ABOUTME: Covers SelectFontFace, SetFontSize, ShowText, TextPath, scaled fonts, ShowGlyphs, ShowTextGlyphs, and GlyphPath.
 
 package context
 
@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/matrix"
 	"github.com/mikowitz/cairo/status"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestContextSelectFontFace tests that SelectFontFace sets the font without error.
@@ -99,3 +101,249 @@ func TestContextTextPathClosedContext(t *testing.T) {
 	// Should not panic on closed context
 	ctx.TextPath("Hello")
 }
+
+// TestContextGetScaledFont verifies the scaled font reflects the current font size.
+func TestContextGetScaledFont(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightNormal)
+	ctx.SetFontSize(18.0)
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	assert.Equal(t, status.Success, sf.Status())
+	fm, err := sf.GetFontMatrix()
+	require.NoError(t, err)
+	assert.Equal(t, 18.0, fm.XX)
+	assert.Equal(t, ctx.FontExtents(), sf.Extents())
+}
+
+// TestContextSetScaledFont verifies SetScaledFont replaces the font size.
+func TestContextSetScaledFont(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	face, err := font.NewToyFace("serif", font.SlantNormal, font.WeightNormal)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+	sf, err := font.NewScaledFont(face, matrix.NewScalingMatrix(30, 30), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	ctx.SetScaledFont(sf)
+	assert.Equal(t, status.Success, ctx.Status())
+	assert.Equal(t, sf.Extents(), ctx.FontExtents())
+
+	// nil and closed scaled fonts are ignored.
+	ctx.SetScaledFont(nil)
+	require.NoError(t, sf.Close())
+	ctx.SetScaledFont(sf)
+	assert.Equal(t, status.Success, ctx.Status())
+}
+
+// TestContextShowGlyphs verifies glyphs are drawn at their own positions and
+// the current point is left untouched.
+func TestContextShowGlyphs(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightBold)
+	ctx.SetFontSize(40.0)
+	ctx.SetSourceRGB(0, 0, 0)
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+	glyphs, err := sf.TextToGlyphs(0, 0, "W")
+	require.NoError(t, err)
+
+	// Position the glyph so its ink box sits in the middle of the surface.
+	ext := ctx.GlyphExtents(glyphs)
+	glyphs[0].X = 50 - ext.XBearing - ext.Width/2
+	glyphs[0].Y = 50 - ext.YBearing - ext.Height/2
+
+	ctx.ShowGlyphs(glyphs)
+	assert.Equal(t, status.Success, ctx.Status())
+	assert.False(t, ctx.HasCurrentPoint())
+
+	surf.Flush()
+	var inked bool
+	for x := 40; x <= 60 && !inked; x++ {
+		inked = pixelAt(t, surf, x, 50).A > 0
+	}
+	assert.True(t, inked, "the glyph should be drawn around the center")
+	assert.Equal(t, uint8(0), pixelAt(t, surf, 2, 2).A)
+
+	ctx.ShowGlyphs(nil)
+	assert.Equal(t, status.Success, ctx.Status())
+}
+
+// TestContextShowTextGlyphs verifies glyphs are drawn with their clusters,
+// and that clusters which do not match the text are rejected.
+func TestContextShowTextGlyphs(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightBold)
+	ctx.SetFontSize(40.0)
+	ctx.SetSourceRGB(0, 0, 0)
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+	glyphs, clusters, flags, err := sf.TextToGlyphsWithClusters(10, 60, "WW")
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.ShowTextGlyphs("WW", glyphs, clusters, flags))
+	assert.Equal(t, status.Success, ctx.Status())
+
+	surf.Flush()
+	var inked bool
+	for x := 10; x <= 90 && !inked; x++ {
+		inked = pixelAt(t, surf, x, 45).A > 0
+	}
+	assert.True(t, inked, "the glyphs should be drawn")
+
+	err = ctx.ShowTextGlyphs("WWW", glyphs, clusters, flags)
+	assert.Equal(t, status.InvalidClusters, err)
+	err = ctx.ShowTextGlyphs("W\xff", glyphs, clusters, flags)
+	assert.Equal(t, status.InvalidString, err)
+	assert.Equal(t, status.Success, ctx.Status(), "rejected clusters should not affect the context")
+
+	assert.NoError(t, ctx.ShowTextGlyphs("", nil, nil, font.TextClusterFlagsNone))
+}
+
+// TestContextGlyphPath verifies glyph outlines are appended to the path.
+func TestContextGlyphPath(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightNormal)
+	ctx.SetFontSize(20.0)
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+	glyphs, err := sf.TextToGlyphs(10, 50, "Path")
+	require.NoError(t, err)
+
+	ctx.GlyphPath(glyphs)
+	assert.Equal(t, status.Success, ctx.Status())
+
+	x1, y1, x2, y2 := ctx.FillExtents()
+	assert.Less(t, x1, x2)
+	assert.Less(t, y1, y2)
+}
+
+// TestContextGlyphMethodsClosedContext tests that glyph methods on a closed context are no-ops.
+func TestContextGlyphMethodsClosedContext(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	_ = ctx.Close()
+
+	glyphs := []font.Glyph{{Index: 36, X: 10, Y: 50}}
+	ctx.ShowGlyphs(glyphs)
+	ctx.GlyphPath(glyphs)
+	assert.Equal(t, font.TextExtents{}, ctx.GlyphExtents(glyphs))
+	err := ctx.ShowTextGlyphs("A", glyphs, []font.TextCluster{{NumBytes: 1, NumGlyphs: 1}}, font.TextClusterFlagsNone)
+	assert.Equal(t, status.NullPointer, err)
+
+	_, err = ctx.GetScaledFont()
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestContextFontOptions verifies font options round-trip through the context.
+func TestContextFontOptions(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	opts, err := font.NewOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = opts.Close()
+	}()
+	opts.SetAntialias(font.AntialiasGray)
+	opts.SetHintStyle(font.HintStyleFull)
+	opts.SetHintMetrics(font.HintMetricsOn)
+
+	ctx.SetFontOptions(opts)
+	assert.Equal(t, status.Success, ctx.Status())
+
+	got, err := ctx.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = got.Close()
+	}()
+	assert.True(t, opts.Equal(got))
+
+	// Later changes to opts do not affect the context.
+	opts.SetHintStyle(font.HintStyleNone)
+	again, err := ctx.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = again.Close()
+	}()
+	assert.Equal(t, font.HintStyleFull, again.GetHintStyle())
+
+	sf, err := ctx.GetScaledFont()
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+	sfOpts, err := sf.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = sfOpts.Close()
+	}()
+	assert.Equal(t, font.AntialiasGray, sfOpts.GetAntialias())
+}
+
+// TestContextFontOptionsClosedContext tests font options on a closed context.
+func TestContextFontOptionsClosedContext(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	_ = ctx.Close()
+
+	ctx.SetFontOptions(nil)
+	_, err := ctx.GetFontOptions()
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestContextSetFontFace verifies SetFontFace replaces the current face.
+func TestContextSetFontFace(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	face, err := font.NewToyFace("monospace", font.SlantItalic, font.WeightBold)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	ctx.SetFontFace(face)
+	assert.Equal(t, status.Success, ctx.Status())
+
+	got, err := ctx.GetFontFace()
+	require.NoError(t, err)
+	defer func() {
+		_ = got.Close()
+	}()
+	assert.Equal(t, face.Ptr(), got.Ptr())
+
+	// nil and closed faces are ignored.
+	ctx.SetFontFace(nil)
+	require.NoError(t, face.Close())
+	ctx.SetFontFace(face)
+	assert.Equal(t, status.Success, ctx.Status())
+}
+
+// TestContextFontFaceClosedContext tests font faces on a closed context.
+func TestContextFontFaceClosedContext(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	_ = ctx.Close()
+
+	_, err := ctx.GetFontFace()
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,241 @@
+// ABOUTME: Tests for group rendering: PushGroup, PushGroupWithContent, PopGroup, PopGroupToSource,
+// ABOUTME: GetGroupTarget, and the WithGroup and WithOpacity conveniences.
+
+package context
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContextPushGroupPopGroupToSource verifies group drawing reaches the
+// target only once the group is painted.
+func TestContextPushGroupPopGroupToSource(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+
+	ctx.PushGroup()
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(10, 10, 20, 20)
+	ctx.Fill()
+	surf.Flush()
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 20, 20), "drawing should be redirected to the group")
+
+	ctx.PopGroupToSource()
+	ctx.Paint()
+	require.Equal(t, status.Success, ctx.Status())
+
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, pixelAt(t, surf, 20, 20))
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 50, 50))
+}
+
+// TestContextPushGroupIsolatesState verifies state changed inside a group is
+// discarded when the group is popped.
+func TestContextPushGroupIsolatesState(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.SetLineWidth(3)
+	ctx.PushGroup()
+	ctx.SetLineWidth(9)
+	ctx.PopGroupToSource()
+
+	assert.Equal(t, 3.0, ctx.GetLineWidth())
+}
+
+// TestContextPopGroup verifies PopGroup returns a surface pattern that can be
+// used as a source later.
+func TestContextPopGroup(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+
+	ctx.PushGroup()
+	ctx.SetSourceRGB(0, 1, 0)
+	ctx.Paint()
+	p, err := ctx.PopGroup()
+	require.NoError(t, err)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	assert.Equal(t, pattern.PatternTypeSurface, p.GetType())
+	assert.IsType(t, &pattern.SurfacePattern{}, p)
+
+	ctx.SetSource(p)
+	ctx.Rectangle(0, 0, 10, 10)
+	ctx.Fill()
+
+	assert.Equal(t, color.RGBA{G: 0xff, A: 0xff}, pixelAt(t, surf, 5, 5))
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 50, 50))
+}
+
+// TestContextPopGroupWithoutPush verifies popping with no active group is an error.
+func TestContextPopGroupWithoutPush(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	p, err := ctx.PopGroup()
+	assert.Nil(t, p)
+	assert.Equal(t, status.InvalidPopGroup, err)
+	assert.Equal(t, status.InvalidPopGroup, ctx.Status())
+}
+
+// TestContextPopGroupToSourceWithoutPush verifies the context records the error.
+func TestContextPopGroupToSourceWithoutPush(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.PopGroupToSource()
+	assert.Equal(t, status.InvalidPopGroup, ctx.Status())
+}
+
+// TestContextPushGroupWithContent verifies the group surface has the requested content.
+func TestContextPushGroupWithContent(t *testing.T) {
+	tests := []struct {
+		content surface.Content
+		format  surface.Format
+	}{
+		{surface.ContentAlpha, surface.FormatA8},
+		{surface.ContentColorAlpha, surface.FormatARGB32},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.content.String(), func(t *testing.T) {
+			ctx := newTestContext(t, 40, 30)
+
+			ctx.PushGroupWithContent(tt.content)
+			target, err := ctx.GetGroupTarget()
+			require.NoError(t, err)
+			defer func() {
+				_ = target.Close()
+			}()
+
+			img, ok := target.(*surface.ImageSurface)
+			require.True(t, ok, "group target of an image surface should be an image surface")
+			assert.Equal(t, tt.format, img.GetFormat())
+			assert.Equal(t, 40, img.GetWidth())
+			assert.Equal(t, 30, img.GetHeight())
+
+			ctx.PopGroupToSource()
+			assert.Equal(t, status.Success, ctx.Status())
+		})
+	}
+}
+
+// TestContextGetGroupTargetWithoutGroup verifies the original target is returned.
+func TestContextGetGroupTargetWithoutGroup(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+
+	target, err := ctx.GetGroupTarget()
+	require.NoError(t, err)
+	assert.Equal(t, surf.Ptr(), target.Ptr())
+
+	// The returned surface holds its own reference.
+	require.NoError(t, target.Close())
+	assert.Equal(t, status.Success, surf.Status())
+}
+
+// TestContextWithGroup verifies the group is composited as one layer with
+// the outer operator and that the source is restored afterwards.
+func TestContextWithGroup(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+
+	ctx.SetSourceRGB(0, 0, 1)
+	ctx.Paint()
+
+	ctx.SetOperator(OperatorSource)
+	ctx.WithGroup(func(ctx *Context) {
+		ctx.SetOperator(OperatorOver)
+		ctx.SetSourceRGB(1, 0, 0)
+		ctx.Rectangle(10, 10, 20, 20)
+		ctx.Fill()
+	})
+	require.Equal(t, status.Success, ctx.Status())
+
+	// OperatorSource replaced the whole target with the group, including
+	// its transparent area.
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, pixelAt(t, surf, 20, 20))
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 50, 50))
+
+	assert.Equal(t, OperatorSource, ctx.GetOperator())
+	src, err := ctx.GetSource()
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+	assert.Equal(t, pattern.PatternTypeSolid, src.GetType())
+}
+
+// TestContextWithOpacity verifies the group is faded as a single layer, so
+// overlapping shapes inside it do not show through one another.
+func TestContextWithOpacity(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 100)
+
+	ctx.SetSourceRGB(0, 0, 1)
+	ctx.WithOpacity(0.5, func(ctx *Context) {
+		ctx.SetSourceRGB(1, 0, 0)
+		ctx.Rectangle(10, 10, 40, 40)
+		ctx.Fill()
+		ctx.Rectangle(30, 30, 40, 40)
+		ctx.Fill()
+	})
+	require.Equal(t, status.Success, ctx.Status())
+
+	// Single and overlapping areas have the same, halved coverage.
+	single := pixelAt(t, surf, 15, 15)
+	overlap := pixelAt(t, surf, 40, 40)
+	assert.InDelta(t, 0x80, single.A, 1)
+	assert.Equal(t, single, overlap)
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 90, 90))
+
+	// The blue source is restored.
+	ctx.Rectangle(80, 80, 10, 10)
+	ctx.Fill()
+	assert.Equal(t, color.RGBA{B: 0xff, A: 0xff}, pixelAt(t, surf, 85, 85))
+}
+
+// TestContextWithGroupPanic verifies a panic in fn discards the group and
+// leaves the context usable.
+func TestContextWithGroupPanic(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		with func(*Context, func(*Context))
+	}{
+		{"WithGroup", (*Context).WithGroup},
+		{"WithOpacity", func(ctx *Context, fn func(*Context)) { ctx.WithOpacity(0.5, fn) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, surf := newTestContextWithSurface(t, 100, 100)
+			ctx.SetSourceRGB(0, 0, 1)
+
+			assert.PanicsWithValue(t, "draw failed", func() {
+				tt.with(ctx, func(ctx *Context) {
+					ctx.SetSourceRGB(1, 0, 0)
+					ctx.Paint()
+					panic("draw failed")
+				})
+			})
+			require.Equal(t, status.Success, ctx.Status())
+			assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 50, 50), "the discarded group should not be painted")
+
+			ctx.Paint()
+			assert.Equal(t, color.RGBA{B: 0xff, A: 0xff}, pixelAt(t, surf, 50, 50), "the source should be restored")
+		})
+	}
+}
+
+// TestContextGroupClosed verifies group methods on a closed context are safe.
+func TestContextGroupClosed(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	require.NoError(t, ctx.Close())
+
+	ctx.PushGroup()
+	ctx.PushGroupWithContent(surface.ContentAlpha)
+	ctx.PopGroupToSource()
+
+	_, err := ctx.PopGroup()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = ctx.GetGroupTarget()
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,65 @@
+// ABOUTME: Masking operations on Context: Mask with any pattern and MaskSurface with a surface.
+// ABOUTME: The mask's alpha channel controls how much of the source is painted at each point.
+
+package context
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/surface"
+)
+
+// Mask paints the current source using the alpha channel of p as a mask.
+// Opaque areas of p are painted with the source, transparent areas are not,
+// and partially transparent areas are painted with proportionally reduced
+// opacity. The color channels of p are ignored.
+//
+// Any pattern can be used as a mask, including gradients, which makes Mask
+// suitable for vignettes and soft edges. The mask is interpreted in the
+// current user space, as the source is.
+//
+// If p is nil or closed, the context enters an error state with
+// status.NullPointer. Other errors, such as a pattern in an error state, are
+// likewise recorded on the context status.
+//
+// Example:
+//
+//	// Fade an image out from left to right.
+//	fade, _ := pattern.NewLinearGradient(0, 0, 200, 0)
+//	defer fade.Close()
+//	fade.AddColorStopRGBA(0, 0, 0, 0, 1)
+//	fade.AddColorStopRGBA(1, 0, 0, 0, 0)
+//	ctx.SetSource(image)
+//	ctx.Mask(fade)
+func (c *Context) Mask(p pattern.Pattern) {
+	var patternPtr unsafe.Pointer
+	if p != nil {
+		patternPtr = p.Ptr()
+	}
+
+	c.withLock(func() {
+		contextMask(c.ptr, patternPtr)
+	})
+}
+
+// MaskSurface paints the current source using the alpha channel of s as a
+// mask, with the surface's origin placed at (x, y) in user space. It is a
+// convenience for calling [Context.Mask] with a surface pattern.
+//
+// Areas outside the surface are treated as fully transparent, so nothing is
+// painted there. A surface with [surface.ContentAlpha], such as an A8 image
+// surface, is the most compact choice for a mask.
+//
+// If s is nil or closed, the context enters an error state with
+// status.NullPointer.
+func (c *Context) MaskSurface(s surface.Surface, x, y float64) {
+	var surfacePtr unsafe.Pointer
+	if s != nil {
+		surfacePtr = unsafe.Pointer(s.Ptr())
+	}
+
+	c.withLock(func() {
+		contextMaskSurface(c.ptr, surfacePtr, x, y)
+	})
+}
@@ -0,0 +1,136 @@
+// ABOUTME: Tests for Mask and MaskSurface on Context.
+// ABOUTME: Covers solid, gradient, and surface masks plus error recording for nil and closed inputs.
+
+package context
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContextMaskSolid verifies a translucent solid mask scales the source alpha.
+func TestContextMaskSolid(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 20, 20)
+
+	mask, err := pattern.NewSolidPatternRGBA(0, 0, 0, 0.5)
+	require.NoError(t, err)
+	defer func() {
+		_ = mask.Close()
+	}()
+
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Mask(mask)
+	require.Equal(t, status.Success, ctx.Status())
+
+	px := pixelAt(t, surf, 10, 10)
+	assert.InDelta(t, 0x80, int(px.R), 1)
+	assert.InDelta(t, 0x80, int(px.A), 1)
+	assert.Zero(t, px.G)
+}
+
+// TestContextMaskGradient verifies a gradient mask produces a fade.
+func TestContextMaskGradient(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 100, 10)
+
+	fade, err := pattern.NewLinearGradient(0, 0, 100, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = fade.Close()
+	}()
+	fade.AddColorStopRGBA(0, 0, 0, 0, 1)
+	fade.AddColorStopRGBA(1, 0, 0, 0, 0)
+
+	ctx.SetSourceRGB(0, 0, 1)
+	ctx.Mask(fade)
+	require.Equal(t, status.Success, ctx.Status())
+
+	left := pixelAt(t, surf, 2, 5)
+	middle := pixelAt(t, surf, 50, 5)
+	right := pixelAt(t, surf, 97, 5)
+	assert.Greater(t, left.A, middle.A)
+	assert.Greater(t, middle.A, right.A)
+	assert.Greater(t, left.A, uint8(0xf0))
+	assert.Less(t, right.A, uint8(0x10))
+}
+
+// TestContextMaskSurface verifies a surface mask is placed at the given offset.
+func TestContextMaskSurface(t *testing.T) {
+	ctx, surf := newTestContextWithSurface(t, 50, 50)
+
+	mask, err := surface.NewImageSurface(surface.FormatA8, 10, 10)
+	require.NoError(t, err)
+	defer func() {
+		_ = mask.Close()
+	}()
+	data, err := mask.GetData()
+	require.NoError(t, err)
+	for i := range data {
+		data[i] = 0xff
+	}
+	mask.MarkDirty()
+
+	ctx.SetSourceRGB(0, 1, 0)
+	ctx.MaskSurface(mask, 20, 20)
+	require.Equal(t, status.Success, ctx.Status())
+
+	assert.Equal(t, color.RGBA{G: 0xff, A: 0xff}, pixelAt(t, surf, 25, 25))
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 5, 5))
+	assert.Equal(t, color.RGBA{}, pixelAt(t, surf, 35, 35))
+}
+
+// TestContextMaskErrors verifies nil and closed masks are recorded on the context status.
+func TestContextMaskErrors(t *testing.T) {
+	t.Run("nil pattern", func(t *testing.T) {
+		ctx := newTestContext(t, 10, 10)
+		ctx.Mask(nil)
+		assert.Equal(t, status.NullPointer, ctx.Status())
+	})
+
+	t.Run("closed pattern", func(t *testing.T) {
+		ctx := newTestContext(t, 10, 10)
+		mask, err := pattern.NewSolidPatternRGBA(0, 0, 0, 1)
+		require.NoError(t, err)
+		require.NoError(t, mask.Close())
+
+		ctx.Mask(mask)
+		assert.Equal(t, status.NullPointer, ctx.Status())
+	})
+
+	t.Run("nil surface", func(t *testing.T) {
+		ctx := newTestContext(t, 10, 10)
+		ctx.MaskSurface(nil, 0, 0)
+		assert.Equal(t, status.NullPointer, ctx.Status())
+	})
+
+	t.Run("closed surface", func(t *testing.T) {
+		ctx := newTestContext(t, 10, 10)
+		mask, err := surface.NewImageSurface(surface.FormatA8, 10, 10)
+		require.NoError(t, err)
+		require.NoError(t, mask.Close())
+
+		ctx.MaskSurface(mask, 0, 0)
+		assert.Equal(t, status.NullPointer, ctx.Status())
+	})
+}
+
+// TestContextMaskClosed verifies masking on a closed context is a no-op.
+func TestContextMaskClosed(t *testing.T) {
+	ctx := newTestContext(t, 10, 10)
+	require.NoError(t, ctx.Close())
+
+	mask, err := pattern.NewSolidPatternRGBA(0, 0, 0, 1)
+	require.NoError(t, err)
+	defer func() {
+		_ = mask.Close()
+	}()
+
+	// Should not panic.
+	ctx.Mask(mask)
+	ctx.MaskSurface(nil, 0, 0)
+}
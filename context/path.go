@@ -0,0 +1,185 @@
+// ABOUTME: Path type holding a copy of a context's path as MoveTo/LineTo/CurveTo/ClosePath segments.
+// ABOUTME: Provides CopyPath, CopyPathFlat, AppendPath, segment iteration and matrix transformation.
+
+package context
+
+import (
+	"iter"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+)
+
+// PathSegmentType identifies the kind of a [PathSegment].
+// These values correspond directly to Cairo's cairo_path_data_type_t enum.
+//
+//go:generate stringer -type=PathSegmentType
+type PathSegmentType int
+
+const (
+	// PathSegmentMoveTo starts a new sub-path at its single point.
+	PathSegmentMoveTo PathSegmentType = iota
+
+	// PathSegmentLineTo adds a straight line to its single point.
+	PathSegmentLineTo
+
+	// PathSegmentCurveTo adds a cubic Bézier spline. Its three points are the
+	// two control points followed by the end point.
+	PathSegmentCurveTo
+
+	// PathSegmentClosePath closes the current sub-path. It has no points.
+	PathSegmentClosePath
+)
+
+// NumPoints returns the number of points a segment of this type carries:
+// 1 for MoveTo and LineTo, 3 for CurveTo and 0 for ClosePath.
+func (t PathSegmentType) NumPoints() int {
+	switch t {
+	case PathSegmentMoveTo, PathSegmentLineTo:
+		return 1
+	case PathSegmentCurveTo:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// PathPoint is a point in a [PathSegment], in user-space coordinates.
+type PathPoint struct {
+	X, Y float64
+}
+
+// PathSegment is a single element of a [Path].
+type PathSegment struct {
+	// Type is the kind of segment.
+	Type PathSegmentType
+	// Points holds Type.NumPoints() points.
+	Points []PathPoint
+}
+
+// Path is a copy of the geometry held by a [Context], as returned by
+// [Context.CopyPath] and [Context.CopyPathFlat].
+//
+// A Path is plain Go data: it does not reference the context it came from
+// and needs no cleanup. It can be inspected, modified, transformed and added
+// back to any context with [Context.AppendPath].
+//
+// Example:
+//
+//	ctx.Rectangle(10, 10, 100, 50)
+//	path, err := ctx.CopyPath()
+//	if err != nil {
+//		return err
+//	}
+//	for seg := range path.All() {
+//		fmt.Println(seg.Type, seg.Points)
+//	}
+type Path struct {
+	Segments []PathSegment
+}
+
+// All returns an iterator over the segments of the path, in order.
+func (p *Path) All() iter.Seq[PathSegment] {
+	return func(yield func(PathSegment) bool) {
+		if p == nil {
+			return
+		}
+		for _, seg := range p.Segments {
+			if !yield(seg) {
+				return
+			}
+		}
+	}
+}
+
+// Transform returns a copy of the path with every point transformed by m.
+// The receiver is not modified.
+//
+// Transforming a path with a matrix and appending it is equivalent to
+// appending it while that matrix is the context's transformation.
+func (p *Path) Transform(m *matrix.Matrix) *Path {
+	if p == nil {
+		return nil
+	}
+
+	out := &Path{Segments: make([]PathSegment, len(p.Segments))}
+	for i, seg := range p.Segments {
+		points := make([]PathPoint, len(seg.Points))
+		for j, pt := range seg.Points {
+			points[j].X, points[j].Y = m.TransformPoint(pt.X, pt.Y)
+		}
+		out.Segments[i] = PathSegment{Type: seg.Type, Points: points}
+	}
+	return out
+}
+
+// validate reports status.InvalidPathData if any segment has an unknown type
+// or the wrong number of points for its type.
+func (p *Path) validate() error {
+	for _, seg := range p.Segments {
+		if seg.Type < PathSegmentMoveTo || seg.Type > PathSegmentClosePath {
+			return status.InvalidPathData
+		}
+		if len(seg.Points) != seg.Type.NumPoints() {
+			return status.InvalidPathData
+		}
+	}
+	return nil
+}
+
+// CopyPath returns a copy of the current path, in user-space coordinates.
+// Curves are preserved as [PathSegmentCurveTo] segments; arcs are returned
+// as the curves Cairo approximates them with.
+//
+// Returns status.NullPointer if the context is closed, or the context's
+// error status if it is in an error state.
+func (c *Context) CopyPath() (*Path, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return contextCopyPath(c.ptr, false)
+}
+
+// CopyPathFlat returns a copy of the current path with every curve replaced
+// by a series of line segments approximating it within the current
+// tolerance. The result contains no [PathSegmentCurveTo] segments.
+//
+// Returns status.NullPointer if the context is closed, or the context's
+// error status if it is in an error state.
+func (c *Context) CopyPathFlat() (*Path, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return contextCopyPath(c.ptr, true)
+}
+
+// AppendPath appends the segments of p to the current path. Points are
+// interpreted in the current user space, so a path copied from one context
+// can be replayed into another with a different transformation.
+//
+// Returns status.NullPointer if the context is closed or p is nil, and
+// status.InvalidPathData if a segment has an unknown type or the wrong number
+// of points. The current path is unchanged if an error is returned.
+func (c *Context) AppendPath(p *Path) error {
+	if p == nil {
+		return status.NullPointer
+	}
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.ptr == nil {
+		return status.NullPointer
+	}
+	contextAppendPath(c.ptr, p)
+	return nil
+}
@@ -0,0 +1,245 @@
+// ABOUTME: Tests for the Path type and CopyPath, CopyPathFlat, and AppendPath on Context.
+// ABOUTME: Covers segment decoding, flattening, round-tripping, iteration, and matrix transformation.
+
+package context
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContextCopyPath verifies each segment type is copied with its points.
+func TestContextCopyPath(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.MoveTo(10, 20)
+	ctx.LineTo(30, 40)
+	ctx.CurveTo(50, 60, 70, 80, 90, 10)
+
+	path, err := ctx.CopyPath()
+	require.NoError(t, err)
+
+	expected := []PathSegment{
+		{Type: PathSegmentMoveTo, Points: []PathPoint{{10, 20}}},
+		{Type: PathSegmentLineTo, Points: []PathPoint{{30, 40}}},
+		{Type: PathSegmentCurveTo, Points: []PathPoint{{50, 60}, {70, 80}, {90, 10}}},
+	}
+	assert.Equal(t, expected, path.Segments)
+}
+
+// TestContextCopyPathClosed verifies a closed sub-path contains a ClosePath segment.
+func TestContextCopyPathClosed(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.Rectangle(10, 20, 30, 40)
+
+	path, err := ctx.CopyPath()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(path.Segments), 5)
+
+	assert.Equal(t, PathSegment{Type: PathSegmentMoveTo, Points: []PathPoint{{10, 20}}}, path.Segments[0])
+	assert.Equal(t, PathSegment{Type: PathSegmentLineTo, Points: []PathPoint{{40, 20}}}, path.Segments[1])
+	assert.Equal(t, PathSegment{Type: PathSegmentLineTo, Points: []PathPoint{{40, 60}}}, path.Segments[2])
+	assert.Equal(t, PathSegment{Type: PathSegmentLineTo, Points: []PathPoint{{10, 60}}}, path.Segments[3])
+	assert.Equal(t, PathSegmentClosePath, path.Segments[4].Type)
+	assert.Empty(t, path.Segments[4].Points)
+}
+
+// TestContextCopyPathEmpty verifies a context without a path returns no segments.
+func TestContextCopyPathEmpty(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	path, err := ctx.CopyPath()
+	require.NoError(t, err)
+	assert.Empty(t, path.Segments)
+}
+
+// TestContextCopyPathUserSpace verifies points are returned in user space.
+func TestContextCopyPathUserSpace(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.Scale(2, 2)
+	ctx.MoveTo(10, 10)
+	ctx.LineTo(20, 5)
+
+	path, err := ctx.CopyPath()
+	require.NoError(t, err)
+	require.Len(t, path.Segments, 2)
+	assert.Equal(t, []PathPoint{{20, 5}}, path.Segments[1].Points)
+}
+
+// TestContextCopyPathFlat verifies curves are replaced by line segments.
+func TestContextCopyPathFlat(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	ctx.Arc(50, 50, 40, 0, math.Pi)
+
+	curved, err := ctx.CopyPath()
+	require.NoError(t, err)
+	flat, err := ctx.CopyPathFlat()
+	require.NoError(t, err)
+
+	hasCurve := false
+	for seg := range curved.All() {
+		hasCurve = hasCurve || seg.Type == PathSegmentCurveTo
+	}
+	assert.True(t, hasCurve, "CopyPath should preserve curves")
+
+	lines := 0
+	for seg := range flat.All() {
+		assert.NotEqual(t, PathSegmentCurveTo, seg.Type)
+		if seg.Type == PathSegmentLineTo {
+			lines++
+		}
+	}
+	assert.Greater(t, lines, 4, "flattened arc should contain many line segments")
+
+	last := flat.Segments[len(flat.Segments)-1].Points[0]
+	assert.InDelta(t, 10.0, last.X, 0.01)
+	assert.InDelta(t, 50.0, last.Y, 0.01)
+}
+
+// TestContextAppendPath verifies a copied path can be replayed into another context.
+func TestContextAppendPath(t *testing.T) {
+	src := newTestContext(t, 100, 100)
+	src.MoveTo(10, 10)
+	src.CurveTo(20, 30, 40, 50, 60, 70)
+	src.LineTo(80, 10)
+	src.ClosePath()
+
+	path, err := src.CopyPath()
+	require.NoError(t, err)
+
+	dst := newTestContext(t, 100, 100)
+	require.NoError(t, dst.AppendPath(path))
+	assert.Equal(t, status.Success, dst.Status())
+
+	copied, err := dst.CopyPath()
+	require.NoError(t, err)
+	assert.Equal(t, path.Segments, copied.Segments)
+
+	x1, y1, x2, y2 := dst.PathExtents()
+	assert.Equal(t, []float64{10, 10, 80, 70}, []float64{x1, y1, x2, y2})
+}
+
+// TestContextAppendPathHandBuilt verifies a path built in Go can be appended and filled.
+func TestContextAppendPathHandBuilt(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+
+	path := &Path{Segments: []PathSegment{
+		{Type: PathSegmentMoveTo, Points: []PathPoint{{20, 20}}},
+		{Type: PathSegmentLineTo, Points: []PathPoint{{80, 20}}},
+		{Type: PathSegmentLineTo, Points: []PathPoint{{80, 80}}},
+		{Type: PathSegmentClosePath},
+	}}
+	require.NoError(t, ctx.AppendPath(path))
+
+	assert.True(t, ctx.InFill(70, 30))
+	assert.False(t, ctx.InFill(30, 70))
+}
+
+// TestContextAppendPathInvalid verifies malformed paths are rejected without
+// changing the current path.
+func TestContextAppendPathInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path *Path
+		want status.Status
+	}{
+		{"nil path", nil, status.NullPointer},
+		{"missing point", &Path{Segments: []PathSegment{{Type: PathSegmentLineTo}}}, status.InvalidPathData},
+		{"too few curve points", &Path{Segments: []PathSegment{
+			{Type: PathSegmentCurveTo, Points: []PathPoint{{1, 1}, {2, 2}}},
+		}}, status.InvalidPathData},
+		{"points on close", &Path{Segments: []PathSegment{
+			{Type: PathSegmentClosePath, Points: []PathPoint{{1, 1}}},
+		}}, status.InvalidPathData},
+		{"unknown type", &Path{Segments: []PathSegment{
+			{Type: PathSegmentType(7), Points: []PathPoint{{1, 1}}},
+		}}, status.InvalidPathData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t, 100, 100)
+			ctx.MoveTo(5, 5)
+
+			assert.Equal(t, tt.want, ctx.AppendPath(tt.path))
+			assert.Equal(t, status.Success, ctx.Status())
+
+			path, err := ctx.CopyPath()
+			require.NoError(t, err)
+			assert.Len(t, path.Segments, 1)
+		})
+	}
+}
+
+// TestPathAllBreak verifies iteration stops when the loop body breaks.
+func TestPathAllBreak(t *testing.T) {
+	path := &Path{Segments: []PathSegment{
+		{Type: PathSegmentMoveTo, Points: []PathPoint{{0, 0}}},
+		{Type: PathSegmentLineTo, Points: []PathPoint{{1, 1}}},
+		{Type: PathSegmentLineTo, Points: []PathPoint{{2, 2}}},
+	}}
+
+	var seen []PathSegmentType
+	for seg := range path.All() {
+		seen = append(seen, seg.Type)
+		if len(seen) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []PathSegmentType{PathSegmentMoveTo, PathSegmentLineTo}, seen)
+
+	var nilPath *Path
+	for range nilPath.All() {
+		t.Fatal("nil path should yield no segments")
+	}
+}
+
+// TestPathTransform verifies Transform maps every point and leaves the original unchanged.
+func TestPathTransform(t *testing.T) {
+	path := &Path{Segments: []PathSegment{
+		{Type: PathSegmentMoveTo, Points: []PathPoint{{1, 2}}},
+		{Type: PathSegmentCurveTo, Points: []PathPoint{{3, 4}, {5, 6}, {7, 8}}},
+		{Type: PathSegmentClosePath},
+	}}
+
+	m := matrix.NewTranslationMatrix(10, 20)
+	m.Scale(2, 2)
+	out := path.Transform(m)
+
+	expected := []PathSegment{
+		{Type: PathSegmentMoveTo, Points: []PathPoint{{12, 24}}},
+		{Type: PathSegmentCurveTo, Points: []PathPoint{{16, 28}, {20, 32}, {24, 36}}},
+		{Type: PathSegmentClosePath, Points: []PathPoint{}},
+	}
+	assert.Equal(t, expected, out.Segments)
+	assert.Equal(t, []PathPoint{{1, 2}}, path.Segments[0].Points)
+}
+
+// TestPathSegmentType verifies point counts and string names.
+func TestPathSegmentType(t *testing.T) {
+	assert.Equal(t, 1, PathSegmentMoveTo.NumPoints())
+	assert.Equal(t, 1, PathSegmentLineTo.NumPoints())
+	assert.Equal(t, 3, PathSegmentCurveTo.NumPoints())
+	assert.Equal(t, 0, PathSegmentClosePath.NumPoints())
+	assert.Equal(t, "PathSegmentCurveTo", PathSegmentCurveTo.String())
+}
+
+// TestContextPathClosed verifies path methods on a closed context return NullPointer.
+func TestContextPathClosed(t *testing.T) {
+	ctx := newTestContext(t, 100, 100)
+	require.NoError(t, ctx.Close())
+
+	_, err := ctx.CopyPath()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = ctx.CopyPathFlat()
+	assert.Equal(t, status.NullPointer, err)
+	assert.Equal(t, status.NullPointer, ctx.AppendPath(&Path{}))
+}
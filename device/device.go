@@ -0,0 +1,179 @@
+// ABOUTME: Defines Device and DeviceType, wrapping cairo_device_t with reference-counted ownership.
+// ABOUTME: Provides Acquire, Release, Flush, Finish, Status and GetType.
+
+package device
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// DeviceType identifies the backend of a Device.
+//
+//go:generate stringer -type=DeviceType
+type DeviceType int
+
+// The values below must match Cairo's cairo_device_type_t C enum exactly.
+const (
+	// DeviceTypeDRM is a Direct Rendering Manager device.
+	DeviceTypeDRM DeviceType = iota
+
+	// DeviceTypeGL is an OpenGL device.
+	DeviceTypeGL
+
+	// DeviceTypeScript is a cairo-script trace.
+	DeviceTypeScript
+
+	// DeviceTypeXCB is an XCB connection.
+	DeviceTypeXCB
+
+	// DeviceTypeXlib is an Xlib display.
+	DeviceTypeXlib
+
+	// DeviceTypeXML is an XML trace.
+	DeviceTypeXML
+
+	// DeviceTypeCOGL is a Cogl context.
+	DeviceTypeCOGL
+
+	// DeviceTypeWin32 is a Win32 device.
+	DeviceTypeWin32
+
+	// DeviceTypeInvalid is returned for a closed Device.
+	DeviceTypeInvalid DeviceType = -1
+)
+
+// Device is the backend resource shared by a family of surfaces.
+//
+// Device is safe for concurrent use. Methods on a closed Device have no
+// effect and getters return zero values.
+type Device struct {
+	sync.RWMutex
+	ptr DevicePtr
+}
+
+// DeviceFromC wraps a C cairo_device_t pointer.
+//
+// This function is primarily used internally when Cairo creates or returns a
+// device, such as a script device or the device of a surface. The returned
+// Device takes ownership of one reference to the C device and releases it
+// when Close() is called or when the finalizer runs, so callers must
+// reference a borrowed pointer before wrapping it.
+func DeviceFromC(ptr unsafe.Pointer) *Device {
+	d := &Device{ptr: DevicePtr(ptr)}
+	runtime.SetFinalizer(d, (*Device).close)
+	return d
+}
+
+// Ptr returns the underlying C cairo_device_t pointer.
+func (d *Device) Ptr() unsafe.Pointer {
+	d.RLock()
+	defer d.RUnlock()
+
+	return unsafe.Pointer(d.ptr) //nolint:gosec
+}
+
+// Close releases this reference to the device. The device itself is
+// destroyed, and finished, once no surface uses it.
+func (d *Device) Close() error {
+	return d.close()
+}
+
+// Status returns the status of the device, or status.NullPointer if it has
+// been closed.
+func (d *Device) Status() status.Status {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.ptr == nil {
+		return status.NullPointer
+	}
+	return deviceStatus(d.ptr)
+}
+
+// GetType returns the backend of the device, or DeviceTypeInvalid if it has
+// been closed.
+func (d *Device) GetType() DeviceType {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.ptr == nil {
+		return DeviceTypeInvalid
+	}
+	return deviceGetType(d.ptr)
+}
+
+// Acquire gives the caller exclusive use of the device's backend until
+// Release is called, so it can be used directly without interference from
+// Cairo. Calls nest: each Acquire must be matched by a Release.
+//
+// Returns status.DeviceFinished if the device has been finished,
+// status.DeviceError if the backend cannot be acquired, or
+// status.NullPointer if the device has been closed.
+func (d *Device) Acquire() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.ptr == nil {
+		return status.NullPointer
+	}
+	if st := deviceAcquire(d.ptr); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+// Release gives the backend back to Cairo after a successful Acquire.
+func (d *Device) Release() {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.ptr == nil {
+		return
+	}
+	deviceRelease(d.ptr)
+}
+
+// Flush completes any pending operations on the device, such as writing
+// buffered output. Call it before using the backend directly.
+func (d *Device) Flush() {
+	d.withLock(func() {
+		deviceFlush(d.ptr)
+	})
+}
+
+// Finish flushes the device and detaches it from its backend, for example
+// closing a script device's output. Surfaces using the device can no longer
+// draw, and Acquire returns status.DeviceFinished. Finish does not release
+// the device; call Close as well.
+func (d *Device) Finish() {
+	d.withLock(func() {
+		deviceFinish(d.ptr)
+	})
+}
+
+func (d *Device) withLock(fn func()) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.ptr == nil {
+		return
+	}
+	fn()
+}
+
+func (d *Device) close() error {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.ptr != nil {
+		deviceClose(d.ptr)
+		runtime.SetFinalizer(d, nil)
+		d.ptr = nil
+	}
+
+	return nil
+}
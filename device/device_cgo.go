@@ -0,0 +1,39 @@
+package device
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+import "C"
+
+import (
+	"github.com/mikowitz/cairo/status"
+)
+
+type DevicePtr *C.cairo_device_t
+
+func deviceClose(ptr DevicePtr) {
+	C.cairo_device_destroy(ptr)
+}
+
+func deviceStatus(ptr DevicePtr) status.Status {
+	return status.Status(C.cairo_device_status(ptr))
+}
+
+func deviceGetType(ptr DevicePtr) DeviceType {
+	return DeviceType(C.cairo_device_get_type(ptr))
+}
+
+func deviceAcquire(ptr DevicePtr) status.Status {
+	return status.Status(C.cairo_device_acquire(ptr))
+}
+
+func deviceRelease(ptr DevicePtr) {
+	C.cairo_device_release(ptr)
+}
+
+func deviceFlush(ptr DevicePtr) {
+	C.cairo_device_flush(ptr)
+}
+
+func deviceFinish(ptr DevicePtr) {
+	C.cairo_device_finish(ptr)
+}
@@ -0,0 +1,121 @@
+// ABOUTME: Tests for Device: type, status, Acquire/Release, Flush, Finish and closed devices.
+// ABOUTME: Uses a script device, the one backend available everywhere Cairo is built with cairo-script.
+
+//go:build !noscript
+
+package device_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mikowitz/cairo/device"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDevice returns a script device writing into a buffer.
+func newTestDevice(t *testing.T) (*device.Device, *bytes.Buffer) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	script, err := surface.NewScriptForWriter(&buf)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = script.Close()
+	})
+	return script.Device, &buf
+}
+
+// TestDeviceType verifies the device type and status.
+func TestDeviceType(t *testing.T) {
+	dev, _ := newTestDevice(t)
+
+	assert.NotNil(t, dev.Ptr())
+	assert.Equal(t, status.Success, dev.Status())
+	assert.Equal(t, device.DeviceTypeScript, dev.GetType())
+	assert.Equal(t, "DeviceTypeScript", dev.GetType().String())
+	assert.Equal(t, "DeviceTypeInvalid", device.DeviceTypeInvalid.String())
+	assert.Equal(t, "DeviceType(42)", device.DeviceType(42).String())
+}
+
+// TestDeviceAcquireRelease verifies that acquisitions nest and that a
+// finished device cannot be acquired.
+func TestDeviceAcquireRelease(t *testing.T) {
+	dev, _ := newTestDevice(t)
+
+	require.NoError(t, dev.Acquire())
+	require.NoError(t, dev.Acquire())
+	dev.Release()
+	dev.Release()
+
+	dev.Flush()
+	assert.Equal(t, status.Success, dev.Status())
+
+	dev.Finish()
+	assert.Equal(t, status.DeviceFinished, dev.Acquire())
+}
+
+// TestDeviceFinish verifies that finishing writes the trace while surfaces
+// still use the device.
+func TestDeviceFinish(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := surface.NewScriptForWriter(&buf)
+	require.NoError(t, err)
+	defer func() {
+		_ = script.Close()
+	}()
+
+	surf, err := surface.NewScriptSurface(script, surface.ContentColorAlpha, 10, 10)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+
+	script.Finish()
+	assert.NotZero(t, buf.Len(), "the trace should be written")
+	assert.Equal(t, status.DeviceFinished, script.Acquire())
+}
+
+// TestDeviceFromSurface verifies that a surface's device is a new reference
+// that can be closed independently.
+func TestDeviceFromSurface(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := surface.NewScriptForWriter(&buf)
+	require.NoError(t, err)
+	defer func() {
+		_ = script.Close()
+	}()
+
+	surf, err := surface.NewScriptSurface(script, surface.ContentColorAlpha, 10, 10)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+
+	dev, err := surf.GetDevice()
+	require.NoError(t, err)
+	require.NoError(t, dev.Close())
+	assert.Equal(t, status.Success, script.Status(), "closing the surface's device should not affect the script")
+}
+
+// TestDeviceClosed verifies that methods on a closed device are safe.
+func TestDeviceClosed(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := surface.NewScriptForWriter(&buf)
+	require.NoError(t, err)
+	dev := script.Device
+
+	require.NoError(t, dev.Close())
+	require.NoError(t, dev.Close())
+
+	assert.Nil(t, dev.Ptr())
+	assert.Equal(t, status.NullPointer, dev.Status())
+	assert.Equal(t, device.DeviceTypeInvalid, dev.GetType())
+	assert.Equal(t, status.NullPointer, dev.Acquire())
+	dev.Release()
+	dev.Flush()
+	dev.Finish()
+}
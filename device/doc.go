@@ -0,0 +1,31 @@
+// ABOUTME: Package device wraps cairo_device_t, the backend resource shared by a family of surfaces.
+// ABOUTME: Provides Acquire/Release for exclusive use of the underlying backend, Flush and Finish.
+
+// Package device provides [Device], Cairo's cairo_device_t.
+//
+// A device is the resource behind a family of surfaces, such as a cairo-script
+// trace file or a GPU context. Surfaces created for the same device share it,
+// and [surface.BaseSurface.GetDevice] returns it for an existing surface.
+// Image, PDF, SVG, PostScript and recording surfaces have no device.
+//
+// Script devices, which record Cairo calls into a trace, are created with
+// surface.NewScript and surface.NewScriptForWriter.
+//
+// # Exclusive Access
+//
+// Code that talks to the backend directly, for example issuing its own
+// OpenGL calls, must bracket that work with [Device.Acquire] and
+// [Device.Release] so Cairo does not use the backend at the same time:
+//
+//	if err := dev.Acquire(); err != nil {
+//	    return err
+//	}
+//	defer dev.Release()
+//
+// # Finishing
+//
+// [Device.Finish] writes any pending output and detaches the device from
+// the backend; surfaces using a finished device can no longer draw.
+// [Device.Close] releases this reference to the device, which is destroyed
+// once no surface uses it.
+package device
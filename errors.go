@@ -1,4 +1,4 @@
-// ABOUTME: Custom error types for Cairo surface, context, and pattern operations.
+// ABOUTME: Custom error types for Cairo surface, context, pattern, and font operations.
 // ABOUTME: Provides structured errors wrapping status codes with additional context.
 package cairo
 
@@ -119,6 +119,44 @@ func (e *PatternError) Is(target error) bool {
 	return e.Status == t.Status
 }
 
+// FontError represents an error that occurred while creating a font face,
+// scaled font or font options. It wraps a status.Status value and includes the font type for
+// additional context.
+type FontError struct {
+	// Status is the underlying Cairo status code.
+	Status status.Status
+	// FontType identifies the kind of font object (e.g., "toy", "scaled", "options").
+	FontType string
+}
+
+// Error implements the error interface.
+func (e *FontError) Error() string {
+	if e.FontType != "" {
+		return fmt.Sprintf("cairo font error (%s): %v", e.FontType, e.Status)
+	}
+	return fmt.Sprintf("cairo font error: %v", e.Status)
+}
+
+// Unwrap returns the underlying status error for use with errors.Is and errors.As.
+func (e *FontError) Unwrap() error {
+	return e.Status
+}
+
+// Is reports whether target matches this error.
+// It matches if target is a *FontError with the same Status,
+// and either target.FontType is empty or equals e.FontType.
+// An empty target.FontType acts as a wildcard and matches any font type.
+func (e *FontError) Is(target error) bool {
+	t, ok := target.(*FontError)
+	if !ok {
+		return false
+	}
+	if t.FontType != "" && t.FontType != e.FontType {
+		return false
+	}
+	return e.Status == t.Status
+}
+
 // wrapSurfaceErr converts a status.Status error into a *SurfaceError with the
 // given surface type. Non-status errors pass through unchanged.
 func wrapSurfaceErr(err error, surfaceType string) error {
@@ -145,3 +183,12 @@ func wrapPatternErr(err error, patternType string) error {
 	}
 	return err
 }
+
+// wrapFontErr converts a status.Status error into a *FontError with the
+// given font type. Non-status errors pass through unchanged.
+func wrapFontErr(err error, fontType string) error {
+	if st, ok := err.(status.Status); ok {
+		return &FontError{Status: st, FontType: fontType}
+	}
+	return err
+}
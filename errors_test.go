@@ -1,4 +1,4 @@
-// ABOUTME: Tests for custom Cairo error types (SurfaceError, ContextError, PatternError).
+// ABOUTME: Tests for custom Cairo error types (SurfaceError, ContextError, PatternError, FontError).
 // ABOUTME: Verifies errors.Is, errors.As, error messages, and type assertions.
 package cairo_test
 
@@ -37,6 +37,14 @@ func TestErrorTypes(t *testing.T) {
 		assert.Equal(t, "linear", pe.PatternType)
 	})
 
+	t.Run("FontError type assertion via errors.As", func(t *testing.T) {
+		fontErr := &cairo.FontError{Status: status.InvalidMatrix, FontType: "scaled"}
+		var fe *cairo.FontError
+		require.True(t, errors.As(fontErr, &fe))
+		assert.Equal(t, status.InvalidMatrix, fe.Status)
+		assert.Equal(t, "scaled", fe.FontType)
+	})
+
 	t.Run("errors.As returns false for wrong type", func(t *testing.T) {
 		surfErr := &cairo.SurfaceError{Status: status.InvalidFormat}
 		var ce *cairo.ContextError
@@ -63,6 +71,12 @@ func TestErrorUnwrapping(t *testing.T) {
 		assert.False(t, errors.Is(patErr, status.InvalidFormat))
 	})
 
+	t.Run("FontError unwraps to status", func(t *testing.T) {
+		fontErr := &cairo.FontError{Status: status.InvalidMatrix}
+		assert.True(t, errors.Is(fontErr, status.InvalidMatrix))
+		assert.False(t, errors.Is(fontErr, status.InvalidFormat))
+	})
+
 	t.Run("errors.Is with matching SurfaceError", func(t *testing.T) {
 		surfErr := &cairo.SurfaceError{Status: status.InvalidFormat, SurfaceType: "image"}
 		target := &cairo.SurfaceError{Status: status.InvalidFormat}
@@ -98,6 +112,12 @@ func TestErrorUnwrapping(t *testing.T) {
 		target := &cairo.PatternError{Status: status.PatternTypeMismatch, PatternType: "solid"}
 		assert.False(t, errors.Is(patErr, target))
 	})
+
+	t.Run("errors.Is with mismatched FontType", func(t *testing.T) {
+		fontErr := &cairo.FontError{Status: status.InvalidMatrix, FontType: "scaled"}
+		assert.True(t, errors.Is(fontErr, &cairo.FontError{Status: status.InvalidMatrix}))
+		assert.False(t, errors.Is(fontErr, &cairo.FontError{Status: status.InvalidMatrix, FontType: "toy"}))
+	})
 }
 
 func TestErrorContext(t *testing.T) {
@@ -133,4 +153,10 @@ func TestErrorContext(t *testing.T) {
 		assert.Contains(t, patErr.Error(), "cairo pattern error")
 		assert.NotContains(t, patErr.Error(), "()")
 	})
+
+	t.Run("FontError includes font type in message", func(t *testing.T) {
+		fontErr := &cairo.FontError{Status: status.InvalidMatrix, FontType: "scaled"}
+		assert.Contains(t, fontErr.Error(), "scaled")
+		assert.NotContains(t, (&cairo.FontError{Status: status.InvalidMatrix}).Error(), "()")
+	})
 }
@@ -195,3 +195,42 @@ func ExampleNewLinearGradient() {
 	// Output:
 	// Gradient type: Linear
 }
+
+// ExampleContext_WithOpacity demonstrates fading a group of overlapping shapes
+// as a single layer.
+func ExampleContext_WithOpacity() {
+	surface, err := cairo.NewImageSurface(cairo.FormatARGB32, 100, 100)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, err := cairo.NewContext(surface)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer surface.Close()
+	defer ctx.Close()
+
+	// Two overlapping squares, composited together at half opacity
+	ctx.WithOpacity(0.5, func(ctx *cairo.Context) {
+		ctx.SetSourceRGB(1, 0, 0)
+		ctx.Rectangle(10, 10, 50, 50)
+		ctx.Fill()
+		ctx.Rectangle(40, 40, 50, 50)
+		ctx.Fill()
+	})
+
+	data, err := surface.GetData()
+	if err != nil {
+		log.Fatal(err)
+	}
+	alpha := func(x, y int) byte {
+		return data[y*surface.GetStride()+x*4+3] // alpha is byte 3 of each ARGB32 pixel on little-endian hosts
+	}
+
+	// Without the group, the overlap would be darker than either square alone
+	fmt.Printf("Overlap matches single coverage: %v\n", alpha(50, 50) == alpha(20, 20))
+
+	// Output:
+	// Overlap matches single coverage: true
+}
@@ -0,0 +1,88 @@
+// ABOUTME: Defines TextCluster and TextClusterFlags, which map glyphs back to the UTF-8 text they represent.
+// ABOUTME: Provides ValidateTextClusters, which applies Cairo's cluster rules before text is shown.
+
+package font
+
+import (
+	"unicode/utf8"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// TextCluster maps a run of bytes in a UTF-8 string to the glyphs that
+// represent it. A slice of clusters lets PDF and SVG output map glyphs back
+// to the original text, so that the text can be searched and copied.
+//
+// Clusters are used with [ScaledFont.TextToGlyphsWithClusters] and
+// Context.ShowTextGlyphs. A cluster usually covers a single character and
+// glyph, but a ligature covers several characters with one glyph, and a
+// character with combining marks may need several glyphs.
+type TextCluster struct {
+	// NumBytes is the number of bytes of UTF-8 text in the cluster.
+	NumBytes int
+
+	// NumGlyphs is the number of glyphs in the cluster.
+	NumGlyphs int
+}
+
+// TextClusterFlags specifies properties of a slice of text clusters.
+//
+//go:generate stringer -type=TextClusterFlags
+type TextClusterFlags int
+
+// The values below must match Cairo's cairo_text_cluster_flags_t C enum exactly.
+const (
+	// TextClusterFlagsNone maps clusters to glyphs in the same order as the
+	// text.
+	TextClusterFlagsNone TextClusterFlags = 0
+
+	// TextClusterFlagBackward maps clusters to glyphs in reverse order: the
+	// first cluster covers the start of the text and the end of the glyphs.
+	// Use it for right-to-left text whose glyphs are in visual order.
+	TextClusterFlagBackward TextClusterFlags = 1
+)
+
+// ValidateTextClusters reports whether clusters map text onto numGlyphs
+// glyphs as Cairo requires: every cluster covers a whole number of
+// characters and at least one byte or glyph, and together the clusters cover
+// all of text and all of the glyphs. Empty text with no glyphs needs no
+// clusters. The rules are the same for backward clusters.
+//
+// Returns status.InvalidString if text is not valid UTF-8, or
+// status.InvalidClusters if the clusters do not match text and numGlyphs.
+func ValidateTextClusters(text string, numGlyphs int, clusters []TextCluster) error {
+	if text == "" && numGlyphs == 0 {
+		return nil
+	}
+	if validClusters(text, numGlyphs, clusters) {
+		return nil
+	}
+	// As in Cairo, report malformed text in preference to bad clusters.
+	if !utf8.ValidString(text) {
+		return status.InvalidString
+	}
+	return status.InvalidClusters
+}
+
+func validClusters(text string, numGlyphs int, clusters []TextCluster) bool {
+	bytes, glyphs := 0, 0
+	for _, c := range clusters {
+		if c.NumBytes < 0 || c.NumGlyphs < 0 {
+			return false
+		}
+		if c.NumBytes == 0 && c.NumGlyphs == 0 {
+			return false
+		}
+		if c.NumBytes > len(text)-bytes || c.NumGlyphs > numGlyphs-glyphs {
+			return false
+		}
+		// A cluster must hold whole characters, so its bytes are valid
+		// UTF-8 on their own.
+		if !utf8.ValidString(text[bytes : bytes+c.NumBytes]) {
+			return false
+		}
+		bytes += c.NumBytes
+		glyphs += c.NumGlyphs
+	}
+	return bytes == len(text) && glyphs == numGlyphs
+}
@@ -0,0 +1,46 @@
+// ABOUTME: Tests for ValidateTextClusters, which checks that clusters map text onto glyphs.
+// ABOUTME: Covers multi-byte characters, ligatures, mismatched counts and malformed UTF-8.
+
+package font
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateTextClusters tests cluster validation against text and glyph counts.
+func TestValidateTextClusters(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		numGlyphs int
+		clusters  []TextCluster
+		want      error
+	}{
+		{"empty", "", 0, nil, nil},
+		{"one_per_character", "aé", 2, []TextCluster{{1, 1}, {2, 1}}, nil},
+		{"ligature", "ffi", 1, []TextCluster{{3, 1}}, nil},
+		{"combining_glyphs", "é", 2, []TextCluster{{2, 2}}, nil},
+		{"glyph_without_text", "a", 2, []TextCluster{{1, 1}, {0, 1}}, nil},
+		{"missing_clusters", "a", 1, nil, status.InvalidClusters},
+		{"too_few_glyphs", "ab", 1, []TextCluster{{1, 1}, {1, 1}}, status.InvalidClusters},
+		{"uncovered_text", "abc", 2, []TextCluster{{1, 1}, {1, 1}}, status.InvalidClusters},
+		{"empty_cluster", "a", 1, []TextCluster{{1, 1}, {0, 0}}, status.InvalidClusters},
+		{"negative", "a", 1, []TextCluster{{2, 1}, {-1, 0}}, status.InvalidClusters},
+		{"split_character", "é", 2, []TextCluster{{1, 1}, {1, 1}}, status.InvalidClusters},
+		{"invalid_utf8", "a\xff", 2, []TextCluster{{1, 1}, {1, 1}}, status.InvalidString},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTextClusters(tt.text, tt.numGlyphs, tt.clusters)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tt.want, err)
+			}
+		})
+	}
+}
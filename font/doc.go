@@ -1,5 +1,5 @@
-// ABOUTME: Package font provides types for Cairo's toy font API.
-// ABOUTME: Defines Slant and Weight types used with SelectFontFace.
+// ABOUTME: Package font provides types for Cairo's toy font API and scaled font API.
+// ABOUTME: Defines Slant and Weight, font faces, scaled fonts, glyphs, and text clusters.
 
 // Package font provides font-related types for Cairo text rendering.
 //
@@ -7,7 +7,7 @@
 //
 // Cairo provides two text rendering APIs:
 //
-// The toy font API (this package) offers simple text rendering with minimal
+// The toy font API offers simple text rendering with minimal
 // configuration. It is sufficient for basic needs such as labels, annotations,
 // and simple overlays. The toy API selects fonts by family name, slant, and
 // weight using the host platform's font system, so results are
@@ -15,9 +15,19 @@
 // and Windows due to differences in available fonts, hinting engines, and
 // antialiasing strategies.
 //
-// The scaled font API (not yet implemented) provides fine-grained control over
-// font metrics, glyph placement, and transformation. It is required for
-// professional typography, internationalization, and precise text layout.
+// The scaled font API ([Face], [ScaledFont] and [Glyph]) provides
+// fine-grained control over font metrics, glyph placement, and
+// transformation. [ScaledFont.TextToGlyphs] converts text into positioned
+// glyphs, which can be adjusted individually for kerning tweaks, justified
+// text or text on a path, and drawn with Context.ShowGlyphs or
+// Context.GlyphPath. A Context's current scaled font is available from
+// Context.GetScaledFont.
+//
+// Positioned glyphs no longer carry the text they came from. To keep text
+// searchable and copyable in PDF output, get [TextCluster] values along with
+// the glyphs from [ScaledFont.TextToGlyphsWithClusters] and draw them with
+// Context.ShowTextGlyphs.
+//
 // Advanced text rendering that needs consistent cross-platform output typically
 // uses Pango, which wraps Cairo's scaled font API (future integration).
 //
@@ -0,0 +1,137 @@
+// ABOUTME: Face wraps cairo_font_face_t, the size-independent font face a ScaledFont is created from.
+// ABOUTME: Provides NewToyFace, FaceFromC, and the FontType enumeration of font backends.
+
+package font
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// FontType identifies the font backend a [Face] or [ScaledFont] was
+// created with.
+//
+//go:generate stringer -type=FontType
+type FontType int
+
+// The iota values below must match Cairo's cairo_font_type_t C enum exactly.
+const (
+	// FontTypeToy is a font created with the toy font API.
+	FontTypeToy FontType = iota
+
+	// FontTypeFT is a FreeType font.
+	FontTypeFT
+
+	// FontTypeWin32 is a Win32 font.
+	FontTypeWin32
+
+	// FontTypeQuartz is a Quartz (macOS) font.
+	FontTypeQuartz
+
+	// FontTypeUser is a user font implemented with callbacks.
+	FontTypeUser
+
+	// FontTypeDWrite is a DirectWrite (Windows) font.
+	FontTypeDWrite
+)
+
+// Face represents a font face: a particular font at no specific size or
+// transformation. Combine a Face with a font matrix and a CTM using
+// [NewScaledFont] to measure and convert text into glyphs.
+//
+// Face is safe for concurrent use. Methods on a closed Face have no effect.
+type Face struct {
+	sync.RWMutex
+	ptr FacePtr
+}
+
+func newFace(ptr FacePtr) *Face {
+	f := &Face{ptr: ptr}
+	runtime.SetFinalizer(f, (*Face).close)
+	return f
+}
+
+// NewToyFace creates a font face from a family name, slant and weight using
+// the toy font API. It selects the same face as [Context.SelectFontFace]
+// with the same arguments, and is subject to the same platform-dependent
+// font lookup.
+//
+// Example:
+//
+//	face, err := font.NewToyFace("sans-serif", font.SlantNormal, font.WeightNormal)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+func NewToyFace(family string, slant Slant, weight Weight) (*Face, error) {
+	ptr := fontFaceCreateToy(family, slant, weight)
+	if st := fontFaceStatus(ptr); st != status.Success {
+		fontFaceClose(ptr)
+		return nil, st
+	}
+	return newFace(ptr), nil
+}
+
+// FaceFromC wraps a C cairo_font_face_t pointer.
+//
+// This function is primarily used internally when retrieving font faces
+// from Cairo C API functions (e.g., cairo_get_font_face). The returned Face
+// takes ownership of one reference to the C font face, so callers must
+// reference a borrowed pointer before wrapping it.
+func FaceFromC(ptr unsafe.Pointer) *Face {
+	return newFace(FacePtr(ptr))
+}
+
+// Ptr returns the underlying pointer for the font face.
+func (f *Face) Ptr() unsafe.Pointer {
+	f.RLock()
+	defer f.RUnlock()
+
+	return unsafe.Pointer(f.ptr) //nolint:gosec
+}
+
+// Close releases the font face. Cairo keeps the underlying face alive for
+// as long as a ScaledFont or Context still uses it.
+func (f *Face) Close() error {
+	return f.close()
+}
+
+// Status returns the status of the font face, or status.NullPointer if it
+// has been closed.
+func (f *Face) Status() status.Status {
+	f.RLock()
+	defer f.RUnlock()
+
+	if f.ptr == nil {
+		return status.NullPointer
+	}
+	return fontFaceStatus(f.ptr)
+}
+
+// GetType returns the font backend the face was created with. It returns
+// FontTypeToy if the face has been closed.
+func (f *Face) GetType() FontType {
+	f.RLock()
+	defer f.RUnlock()
+
+	if f.ptr == nil {
+		return FontTypeToy
+	}
+	return fontFaceGetType(f.ptr)
+}
+
+func (f *Face) close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.ptr != nil {
+		fontFaceClose(f.ptr)
+		runtime.SetFinalizer(f, nil)
+		f.ptr = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package font
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+)
+
+type FacePtr *C.cairo_font_face_t
+
+type ScaledFontPtr *C.cairo_scaled_font_t
+
+func fontFaceCreateToy(family string, slant Slant, weight Weight) FacePtr {
+	cFamily := C.CString(family)
+	defer C.free(unsafe.Pointer(cFamily))
+	return FacePtr(C.cairo_toy_font_face_create(cFamily, C.cairo_font_slant_t(slant), C.cairo_font_weight_t(weight)))
+}
+
+func fontFaceClose(ptr FacePtr) {
+	C.cairo_font_face_destroy(ptr)
+}
+
+func fontFaceStatus(ptr FacePtr) status.Status {
+	return status.Status(C.cairo_font_face_status(ptr))
+}
+
+func fontFaceGetType(ptr FacePtr) FontType {
+	return FontType(C.cairo_font_face_get_type(ptr))
+}
+
+// scaledFontCreate creates a scaled font. If options is nil, default
+// options are used.
+func scaledFontCreate(face FacePtr, fontMatrix, ctm unsafe.Pointer, options OptionsPtr) ScaledFontPtr {
+	if options == nil {
+		options = C.cairo_font_options_create()
+		defer C.cairo_font_options_destroy(options)
+	}
+
+	return ScaledFontPtr(C.cairo_scaled_font_create(
+		face,
+		(*C.cairo_matrix_t)(fontMatrix),
+		(*C.cairo_matrix_t)(ctm),
+		options,
+	))
+}
+
+func scaledFontClose(ptr ScaledFontPtr) {
+	C.cairo_scaled_font_destroy(ptr)
+}
+
+func scaledFontStatus(ptr ScaledFontPtr) status.Status {
+	return status.Status(C.cairo_scaled_font_status(ptr))
+}
+
+func scaledFontGetType(ptr ScaledFontPtr) FontType {
+	return FontType(C.cairo_scaled_font_get_type(ptr))
+}
+
+func scaledFontExtents(ptr ScaledFontPtr) FontExtents {
+	var extents C.cairo_font_extents_t
+	C.cairo_scaled_font_extents(ptr, &extents)
+	return FontExtents{
+		Ascent:      float64(extents.ascent),
+		Descent:     float64(extents.descent),
+		Height:      float64(extents.height),
+		MaxXAdvance: float64(extents.max_x_advance),
+		MaxYAdvance: float64(extents.max_y_advance),
+	}
+}
+
+func textExtentsFromC(extents *C.cairo_text_extents_t) TextExtents {
+	return TextExtents{
+		XBearing: float64(extents.x_bearing),
+		YBearing: float64(extents.y_bearing),
+		Width:    float64(extents.width),
+		Height:   float64(extents.height),
+		XAdvance: float64(extents.x_advance),
+		YAdvance: float64(extents.y_advance),
+	}
+}
+
+func scaledFontTextExtents(ptr ScaledFontPtr, text string) TextExtents {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+	var extents C.cairo_text_extents_t
+	C.cairo_scaled_font_text_extents(ptr, cText, &extents)
+	return textExtentsFromC(&extents)
+}
+
+// glyphsToC converts glyphs to cairo_glyph_t values. The result is Go
+// memory holding no Go pointers, so it may be passed to Cairo directly.
+func glyphsToC(glyphs []Glyph) []C.cairo_glyph_t {
+	cGlyphs := make([]C.cairo_glyph_t, len(glyphs))
+	for i, g := range glyphs {
+		cGlyphs[i] = C.cairo_glyph_t{
+			index: C.ulong(g.Index),
+			x:     C.double(g.X),
+			y:     C.double(g.Y),
+		}
+	}
+	return cGlyphs
+}
+
+func scaledFontGlyphExtents(ptr ScaledFontPtr, glyphs []Glyph) TextExtents {
+	cGlyphs := glyphsToC(glyphs)
+	var extents C.cairo_text_extents_t
+	C.cairo_scaled_font_glyph_extents(ptr, &cGlyphs[0], C.int(len(cGlyphs)), &extents)
+	return textExtentsFromC(&extents)
+}
+
+func scaledFontTextToGlyphs(ptr ScaledFontPtr, x, y float64, text string) ([]Glyph, error) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var cGlyphs *C.cairo_glyph_t
+	var numGlyphs C.int
+	st := status.Status(C.cairo_scaled_font_text_to_glyphs(
+		ptr,
+		C.double(x), C.double(y),
+		cText, C.int(len(text)),
+		&cGlyphs, &numGlyphs,
+		nil, nil, nil,
+	))
+	if st != status.Success {
+		return nil, st
+	}
+	defer C.cairo_glyph_free(cGlyphs)
+
+	return glyphsFromC(cGlyphs, numGlyphs), nil
+}
+
+func scaledFontTextToGlyphsWithClusters(ptr ScaledFontPtr, x, y float64, text string) ([]Glyph, []TextCluster, TextClusterFlags, error) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var cGlyphs *C.cairo_glyph_t
+	var numGlyphs C.int
+	var cClusters *C.cairo_text_cluster_t
+	var numClusters C.int
+	var cFlags C.cairo_text_cluster_flags_t
+	st := status.Status(C.cairo_scaled_font_text_to_glyphs(
+		ptr,
+		C.double(x), C.double(y),
+		cText, C.int(len(text)),
+		&cGlyphs, &numGlyphs,
+		&cClusters, &numClusters, &cFlags,
+	))
+	if st != status.Success {
+		return nil, nil, TextClusterFlagsNone, st
+	}
+	defer C.cairo_glyph_free(cGlyphs)
+	defer C.cairo_text_cluster_free(cClusters)
+
+	clusters := make([]TextCluster, int(numClusters))
+	if numClusters > 0 {
+		for i, c := range unsafe.Slice(cClusters, int(numClusters)) {
+			clusters[i] = TextCluster{
+				NumBytes:  int(c.num_bytes),
+				NumGlyphs: int(c.num_glyphs),
+			}
+		}
+	}
+	return glyphsFromC(cGlyphs, numGlyphs), clusters, TextClusterFlags(cFlags), nil
+}
+
+// glyphsFromC copies n glyphs from a Cairo glyph array.
+func glyphsFromC(cGlyphs *C.cairo_glyph_t, n C.int) []Glyph {
+	glyphs := make([]Glyph, int(n))
+	if n == 0 {
+		return glyphs
+	}
+	for i, g := range unsafe.Slice(cGlyphs, int(n)) {
+		glyphs[i] = Glyph{
+			Index: uint64(g.index),
+			X:     float64(g.x),
+			Y:     float64(g.y),
+		}
+	}
+	return glyphs
+}
+
+// matrixFromC copies a stack cairo_matrix_t into a heap allocation owned by
+// the returned Matrix.
+func matrixFromC(mStack *C.cairo_matrix_t) *matrix.Matrix {
+	mHeap := (*C.cairo_matrix_t)(C.malloc(C.sizeof_cairo_matrix_t))
+	*mHeap = *mStack
+
+	return matrix.FromPointer(unsafe.Pointer(mHeap))
+}
+
+func scaledFontGetFontMatrix(ptr ScaledFontPtr) *matrix.Matrix {
+	var mStack C.cairo_matrix_t
+	C.cairo_scaled_font_get_font_matrix(ptr, &mStack)
+	return matrixFromC(&mStack)
+}
+
+func scaledFontGetCTM(ptr ScaledFontPtr) *matrix.Matrix {
+	var mStack C.cairo_matrix_t
+	C.cairo_scaled_font_get_ctm(ptr, &mStack)
+	return matrixFromC(&mStack)
+}
+
+func scaledFontGetScaleMatrix(ptr ScaledFontPtr) *matrix.Matrix {
+	var mStack C.cairo_matrix_t
+	C.cairo_scaled_font_get_scale_matrix(ptr, &mStack)
+	return matrixFromC(&mStack)
+}
+
+func scaledFontGetFontOptions(ptr ScaledFontPtr) OptionsPtr {
+	options := C.cairo_font_options_create()
+	C.cairo_scaled_font_get_font_options(ptr, options)
+	return OptionsPtr(options)
+}
+
+func scaledFontGetFontFace(ptr ScaledFontPtr) FacePtr {
+	face := C.cairo_scaled_font_get_font_face(ptr)
+	C.cairo_font_face_reference(face)
+	return FacePtr(face)
+}
@@ -0,0 +1,78 @@
+// ABOUTME: Creates font faces from font files and in-memory font data through Cairo's FreeType backend.
+// ABOUTME: Provides NewFaceFromFile and NewFaceFromBytes for reproducible text without fontconfig lookup.
+
+//go:build !noft
+
+package font
+
+import "github.com/mikowitz/cairo/status"
+
+// NewFaceFromFile creates a font face from the font file at path, using
+// Cairo's FreeType backend. index selects the face within a font collection
+// such as a .ttc file; use 0 for single-face files.
+//
+// Unlike the toy font API, the face does not depend on the fonts installed
+// on the host, so output is reproducible across machines.
+//
+// Returns status.InvalidIndex if index is negative, status.FileNotFound if
+// the file cannot be opened, or status.FreetypeError if FreeType cannot load
+// the font.
+//
+// Requires Cairo's FreeType backend (cairo-ft pkg-config entry). Build with
+// -tags noft to exclude it.
+//
+// Example:
+//
+//	face, err := font.NewFaceFromFile("fonts/Inter-Regular.ttf", 0)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+//
+//	ctx.SetFontFace(face)
+//	ctx.SetFontSize(16)
+func NewFaceFromFile(path string, index int) (*Face, error) {
+	if index < 0 {
+		return nil, status.InvalidIndex
+	}
+
+	ptr, st := ftFaceCreateFromFile(path, index)
+	if st != status.Success {
+		return nil, st
+	}
+	return newFace(ptr), nil
+}
+
+// NewFaceFromBytes creates a font face from font data held in memory, such
+// as a font embedded in the binary with go:embed, using Cairo's FreeType
+// backend. The first face in the data is used.
+//
+// The data is copied, so the slice may be reused after the call.
+//
+// Returns status.NullPointer if data is empty, or status.FreetypeError if
+// FreeType cannot load the font.
+//
+// Requires Cairo's FreeType backend (cairo-ft pkg-config entry). Build with
+// -tags noft to exclude it.
+//
+// Example:
+//
+//	//go:embed fonts/Brand-Bold.otf
+//	var brandBold []byte
+//
+//	face, err := font.NewFaceFromBytes(brandBold)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+func NewFaceFromBytes(data []byte) (*Face, error) {
+	if len(data) == 0 {
+		return nil, status.NullPointer
+	}
+
+	ptr, st := ftFaceCreateFromBytes(data)
+	if st != status.Success {
+		return nil, st
+	}
+	return newFace(ptr), nil
+}
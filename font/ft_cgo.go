@@ -0,0 +1,146 @@
+// ABOUTME: CGO bindings for Cairo's FreeType font backend.
+// ABOUTME: Loads FT_Face objects from files or memory and ties their lifetime to the Cairo font face.
+
+//go:build !noft
+
+package font
+
+// #cgo pkg-config: cairo-ft freetype2
+// #include <cairo-ft.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+// #include <pthread.h>
+// #include <stdlib.h>
+// #include <string.h>
+//
+// // FreeType requires calls that create or destroy faces on a library to be
+// // serialized. Cairo may destroy a font face on any thread, so every such
+// // call goes through _ftLock.
+// static pthread_mutex_t _ftLock = PTHREAD_MUTEX_INITIALIZER;
+// static FT_Library _ftLibrary = NULL;
+//
+// // _ftFace holds an FT_Face and the memory it was loaded from, if any,
+// // until Cairo destroys the font face.
+// typedef struct {
+//     FT_Face face;
+//     void *data;
+// } _ftFace;
+//
+// static void _ftFaceDestroy(void *p) {
+//     _ftFace *f = p;
+//     pthread_mutex_lock(&_ftLock);
+//     FT_Done_Face(f->face);
+//     pthread_mutex_unlock(&_ftLock);
+//     free(f->data);
+//     free(f);
+// }
+//
+// static cairo_user_data_key_t _ftFaceKey;
+//
+// // _ftFaceCreate wraps a loaded FT_Face in a Cairo font face that owns it.
+// static cairo_font_face_t *_ftFaceCreate(FT_Face face, void *data, cairo_status_t *st) {
+//     _ftFace *f = malloc(sizeof(_ftFace));
+//     if (f == NULL) {
+//         pthread_mutex_lock(&_ftLock);
+//         FT_Done_Face(face);
+//         pthread_mutex_unlock(&_ftLock);
+//         free(data);
+//         *st = CAIRO_STATUS_NO_MEMORY;
+//         return NULL;
+//     }
+//     f->face = face;
+//     f->data = data;
+//
+//     cairo_font_face_t *ff = cairo_ft_font_face_create_for_ft_face(face, 0);
+//     *st = cairo_font_face_status(ff);
+//     if (*st == CAIRO_STATUS_SUCCESS) {
+//         *st = cairo_font_face_set_user_data(ff, &_ftFaceKey, f, _ftFaceDestroy);
+//     }
+//     if (*st != CAIRO_STATUS_SUCCESS) {
+//         cairo_font_face_destroy(ff);
+//         _ftFaceDestroy(f);
+//         return NULL;
+//     }
+//     return ff;
+// }
+//
+// // _ftLoad loads a face from path, or from size bytes at data when path is
+// // NULL, returning the FreeType error code.
+// static FT_Error _ftLoad(const char *path, const void *data, size_t size, long index, FT_Face *face) {
+//     FT_Error err = 0;
+//     pthread_mutex_lock(&_ftLock);
+//     if (_ftLibrary == NULL) {
+//         err = FT_Init_FreeType(&_ftLibrary);
+//     }
+//     if (err == 0) {
+//         if (path != NULL) {
+//             err = FT_New_Face(_ftLibrary, path, index, face);
+//         } else {
+//             err = FT_New_Memory_Face(_ftLibrary, data, (FT_Long)size, index, face);
+//         }
+//     }
+//     pthread_mutex_unlock(&_ftLock);
+//     return err;
+// }
+//
+// static cairo_font_face_t *_ftFaceCreateFromFile(const char *path, long index, int *ftErr, cairo_status_t *st) {
+//     FT_Face face;
+//     *ftErr = _ftLoad(path, NULL, 0, index, &face);
+//     if (*ftErr != 0) {
+//         *st = CAIRO_STATUS_FREETYPE_ERROR;
+//         return NULL;
+//     }
+//     return _ftFaceCreate(face, NULL, st);
+// }
+//
+// // _ftFaceCreateFromBytes takes ownership of data, which must be allocated
+// // with malloc and stay alive for as long as the face.
+// static cairo_font_face_t *_ftFaceCreateFromBytes(void *data, size_t size, int *ftErr, cairo_status_t *st) {
+//     FT_Face face;
+//     *ftErr = _ftLoad(NULL, data, size, 0, &face);
+//     if (*ftErr != 0) {
+//         free(data);
+//         *st = CAIRO_STATUS_FREETYPE_ERROR;
+//         return NULL;
+//     }
+//     return _ftFaceCreate(face, data, st);
+// }
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// ftErrCannotOpenResource is FreeType's FT_Err_Cannot_Open_Resource, returned
+// when a font file does not exist or cannot be read.
+const ftErrCannotOpenResource = 0x01
+
+func ftFaceCreateFromFile(path string, index int) (FacePtr, status.Status) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var ftErr C.int
+	var st C.cairo_status_t
+	ptr := C._ftFaceCreateFromFile(cPath, C.long(index), &ftErr, &st)
+	if ftErr == ftErrCannotOpenResource {
+		return nil, status.FileNotFound
+	}
+	if ptr == nil {
+		return nil, status.Status(st)
+	}
+	return FacePtr(ptr), status.Success
+}
+
+func ftFaceCreateFromBytes(data []byte) (FacePtr, status.Status) {
+	cData := C.CBytes(data)
+
+	var ftErr C.int
+	var st C.cairo_status_t
+	ptr := C._ftFaceCreateFromBytes(cData, C.size_t(len(data)), &ftErr, &st)
+	if ptr == nil {
+		return nil, status.Status(st)
+	}
+	return FacePtr(ptr), status.Success
+}
@@ -0,0 +1,90 @@
+// ABOUTME: Tests for FreeType font faces loaded from files and from memory.
+// ABOUTME: Uses the font committed under internal/testfont, so results do not depend on the host.
+
+//go:build !noft
+
+package font
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikowitz/cairo/internal/testfont"
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requireUsableFace verifies face is a FreeType face that produces glyphs.
+func requireUsableFace(t *testing.T, face *Face) {
+	t.Helper()
+
+	assert.Equal(t, status.Success, face.Status())
+	assert.Equal(t, FontTypeFT, face.GetType())
+
+	sf, err := NewScaledFont(face, matrix.NewScalingMatrix(16, 16), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+	assert.Greater(t, sf.Extents().Height, 0.0)
+}
+
+// TestNewFaceFromFile tests loading a font face from a file.
+func TestNewFaceFromFile(t *testing.T) {
+	path := testfont.Path()
+
+	t.Run("valid", func(t *testing.T) {
+		face, err := NewFaceFromFile(path, 0)
+		require.NoError(t, err)
+		defer func() {
+			_ = face.Close()
+		}()
+
+		requireUsableFace(t, face)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		face, err := NewFaceFromFile(filepath.Join(t.TempDir(), "missing.ttf"), 0)
+		assert.Nil(t, face)
+		assert.Equal(t, status.FileNotFound, err)
+	})
+
+	t.Run("negative_index", func(t *testing.T) {
+		face, err := NewFaceFromFile(path, -1)
+		assert.Nil(t, face)
+		assert.Equal(t, status.InvalidIndex, err)
+	})
+
+	t.Run("not_a_font", func(t *testing.T) {
+		notFont := filepath.Join(t.TempDir(), "notes.ttf")
+		require.NoError(t, os.WriteFile(notFont, []byte("not a font"), 0o600))
+
+		face, err := NewFaceFromFile(notFont, 0)
+		assert.Nil(t, face)
+		assert.Equal(t, status.FreetypeError, err)
+	})
+}
+
+// TestNewFaceFromBytes tests loading a font face from memory.
+func TestNewFaceFromBytes(t *testing.T) {
+	data := testfont.Bytes()
+
+	face, err := NewFaceFromBytes(data)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	// The data is copied, so clobbering the slice must not affect the face.
+	clear(data)
+	requireUsableFace(t, face)
+
+	_, err = NewFaceFromBytes(nil)
+	assert.Equal(t, status.NullPointer, err)
+
+	_, err = NewFaceFromBytes([]byte("not a font"))
+	assert.Equal(t, status.FreetypeError, err)
+}
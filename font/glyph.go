@@ -0,0 +1,21 @@
+// ABOUTME: Defines the Glyph type, a positioned glyph index used for glyph-level text rendering.
+// ABOUTME: Glyph corresponds to cairo_glyph_t and is used by ScaledFont and Context.ShowGlyphs.
+
+package font
+
+// Glyph holds a glyph index and the position at which to draw it.
+//
+// Glyph indices are specific to a font face and are not Unicode code points;
+// use [ScaledFont.TextToGlyphs] to convert text into glyphs. X and Y give the
+// position of the glyph's origin on the baseline, in user-space coordinates
+// when drawing with a Context.
+type Glyph struct {
+	// Index is the index of the glyph in the font face.
+	Index uint64
+
+	// X is the horizontal position of the glyph's origin.
+	X float64
+
+	// Y is the vertical position of the glyph's origin.
+	Y float64
+}
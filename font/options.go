@@ -0,0 +1,446 @@
+// ABOUTME: Options wraps cairo_font_options_t, which controls how text is rasterized and hinted.
+// ABOUTME: Defines Antialias, SubpixelOrder, HintStyle, HintMetrics, and ColorMode, plus Merge/Equal/Hash.
+
+package font
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// Antialias specifies the type of antialiasing used when rendering glyphs.
+//
+//go:generate stringer -type=Antialias
+type Antialias int
+
+// The iota values below must match Cairo's cairo_antialias_t C enum exactly.
+const (
+	// AntialiasDefault uses the default antialiasing for the font backend
+	// and target surface.
+	AntialiasDefault Antialias = iota
+
+	// AntialiasNone uses a bilevel alpha mask.
+	AntialiasNone
+
+	// AntialiasGray performs single-color antialiasing.
+	AntialiasGray
+
+	// AntialiasSubpixel performs antialiasing by taking advantage of the
+	// order of subpixel elements on devices such as LCD panels.
+	AntialiasSubpixel
+
+	// AntialiasFast hints that the backend should perform some
+	// antialiasing but prefer speed over quality.
+	AntialiasFast
+
+	// AntialiasGood hints that the backend should balance quality against
+	// performance.
+	AntialiasGood
+
+	// AntialiasBest hints that the backend should render at the highest
+	// quality, sacrificing speed if necessary.
+	AntialiasBest
+)
+
+// SubpixelOrder specifies the order of color elements within each pixel on
+// the display device when rendering with AntialiasSubpixel.
+//
+//go:generate stringer -type=SubpixelOrder
+type SubpixelOrder int
+
+// The iota values below must match Cairo's cairo_subpixel_order_t C enum exactly.
+const (
+	// SubpixelOrderDefault uses the default subpixel order for the target device.
+	SubpixelOrderDefault SubpixelOrder = iota
+
+	// SubpixelOrderRGB has subpixel elements arranged horizontally with red at the left.
+	SubpixelOrderRGB
+
+	// SubpixelOrderBGR has subpixel elements arranged horizontally with blue at the left.
+	SubpixelOrderBGR
+
+	// SubpixelOrderVRGB has subpixel elements arranged vertically with red at the top.
+	SubpixelOrderVRGB
+
+	// SubpixelOrderVBGR has subpixel elements arranged vertically with blue at the top.
+	SubpixelOrderVBGR
+)
+
+// HintStyle specifies how strongly glyph outlines are fitted to the pixel
+// grid to improve contrast, at the cost of fidelity to the original shapes.
+//
+//go:generate stringer -type=HintStyle
+type HintStyle int
+
+// The iota values below must match Cairo's cairo_hint_style_t C enum exactly.
+const (
+	// HintStyleDefault uses the default hint style for the font backend and target device.
+	HintStyleDefault HintStyle = iota
+
+	// HintStyleNone does not hint outlines.
+	HintStyleNone
+
+	// HintStyleSlight hints outlines slightly to improve contrast while
+	// retaining good fidelity to the original shapes.
+	HintStyleSlight
+
+	// HintStyleMedium hints outlines with medium strength.
+	HintStyleMedium
+
+	// HintStyleFull hints outlines to maximize contrast.
+	HintStyleFull
+)
+
+// HintMetrics specifies whether font metrics such as advances and line
+// heights are rounded to integer device-space values.
+//
+//go:generate stringer -type=HintMetrics
+type HintMetrics int
+
+// The iota values below must match Cairo's cairo_hint_metrics_t C enum exactly.
+const (
+	// HintMetricsDefault uses hinted metrics in the default manner for the
+	// font backend and target device.
+	HintMetricsDefault HintMetrics = iota
+
+	// HintMetricsOff does not hint font metrics.
+	HintMetricsOff
+
+	// HintMetricsOn hints font metrics.
+	HintMetricsOn
+)
+
+// ColorMode specifies whether color fonts, such as emoji fonts, are
+// rendered in color.
+//
+//go:generate stringer -type=ColorMode
+type ColorMode int
+
+// The iota values below must match Cairo's cairo_color_mode_t C enum exactly.
+const (
+	// ColorModeDefault uses the default color mode for the font backend and target device.
+	ColorModeDefault ColorMode = iota
+
+	// ColorModeNoColor disables rendering color glyphs; glyphs are always
+	// rendered as outlines.
+	ColorModeNoColor
+
+	// ColorModeColor enables rendering color glyphs.
+	ColorModeColor
+)
+
+// ColorPaletteDefault is the index of the default color palette of a color font.
+const ColorPaletteDefault = 0
+
+// Options holds the options used when rendering text: antialiasing,
+// subpixel order, hinting, color font handling and font variations.
+//
+// Options are applied to a Context with Context.SetFontOptions, where they
+// are merged with the options of the target surface, or passed to
+// [NewScaledFontWithOptions]. Every option starts out as its Default value,
+// which lets the surface decide.
+//
+// Options is safe for concurrent use. Methods on closed Options have no
+// effect and getters return the Default values.
+//
+// Example:
+//
+//	opts, err := font.NewOptions()
+//	if err != nil {
+//	    return err
+//	}
+//	defer opts.Close()
+//
+//	opts.SetAntialias(font.AntialiasGray)
+//	opts.SetHintStyle(font.HintStyleFull)
+//	opts.SetHintMetrics(font.HintMetricsOn)
+//	ctx.SetFontOptions(opts)
+type Options struct {
+	sync.RWMutex
+	ptr OptionsPtr
+}
+
+func newOptions(ptr OptionsPtr) *Options {
+	o := &Options{ptr: ptr}
+	runtime.SetFinalizer(o, (*Options).close)
+	return o
+}
+
+// NewOptions creates a new set of font options with every option set to its
+// default value.
+//
+// Returns status.NoMemory if the options cannot be allocated.
+func NewOptions() (*Options, error) {
+	ptr := optionsCreate()
+	if st := optionsStatus(ptr); st != status.Success {
+		optionsClose(ptr)
+		return nil, st
+	}
+	return newOptions(ptr), nil
+}
+
+// OptionsFromC wraps a C cairo_font_options_t pointer.
+//
+// This function is primarily used internally when retrieving font options
+// from Cairo C API functions (e.g., cairo_get_font_options). The returned
+// Options takes ownership of the C font options and destroys them when
+// Close() is called or when the finalizer runs.
+func OptionsFromC(ptr unsafe.Pointer) *Options {
+	return newOptions(OptionsPtr(ptr))
+}
+
+// Ptr returns the underlying pointer for the font options.
+func (o *Options) Ptr() unsafe.Pointer {
+	o.RLock()
+	defer o.RUnlock()
+
+	return unsafe.Pointer(o.ptr) //nolint:gosec
+}
+
+// Close releases the font options.
+func (o *Options) Close() error {
+	return o.close()
+}
+
+// Status returns the status of the font options, or status.NullPointer if
+// they have been closed.
+func (o *Options) Status() status.Status {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return status.NullPointer
+	}
+	return optionsStatus(o.ptr)
+}
+
+// Copy returns a new, independent copy of the font options.
+//
+// Returns status.NullPointer if the options have been closed.
+func (o *Options) Copy() (*Options, error) {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return nil, status.NullPointer
+	}
+	ptr := optionsCopy(o.ptr)
+	if st := optionsStatus(ptr); st != status.Success {
+		optionsClose(ptr)
+		return nil, st
+	}
+	return newOptions(ptr), nil
+}
+
+// Merge overwrites the options in o with every option of other that is not
+// set to its Default value. Variations are appended to those of o. Nil or
+// closed options are ignored.
+func (o *Options) Merge(other *Options) {
+	if other == nil || other == o {
+		return
+	}
+	otherPtr := other.Ptr()
+	if otherPtr == nil {
+		return
+	}
+
+	o.withLock(func() {
+		optionsMerge(o.ptr, OptionsPtr(otherPtr))
+	})
+}
+
+// Equal reports whether o and other hold the same options. Nil or closed
+// options are equal to nothing.
+func (o *Options) Equal(other *Options) bool {
+	if other == nil {
+		return false
+	}
+	if other == o {
+		return o.Ptr() != nil
+	}
+	otherPtr := other.Ptr()
+	if otherPtr == nil {
+		return false
+	}
+
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return false
+	}
+	return optionsEqual(o.ptr, OptionsPtr(otherPtr))
+}
+
+// Hash returns a hash of the options, suitable as a cache key together with
+// Equal. Options that are Equal have the same hash. It returns 0 if the
+// options have been closed.
+func (o *Options) Hash() uint64 {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return 0
+	}
+	return optionsHash(o.ptr)
+}
+
+// SetAntialias sets the antialiasing mode for rendering text.
+func (o *Options) SetAntialias(antialias Antialias) {
+	o.withLock(func() {
+		optionsSetAntialias(o.ptr, antialias)
+	})
+}
+
+// GetAntialias returns the antialiasing mode for rendering text.
+func (o *Options) GetAntialias() Antialias {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return AntialiasDefault
+	}
+	return optionsGetAntialias(o.ptr)
+}
+
+// SetSubpixelOrder sets the order of color elements within each pixel,
+// used when the antialiasing mode is AntialiasSubpixel.
+func (o *Options) SetSubpixelOrder(order SubpixelOrder) {
+	o.withLock(func() {
+		optionsSetSubpixelOrder(o.ptr, order)
+	})
+}
+
+// GetSubpixelOrder returns the subpixel order.
+func (o *Options) GetSubpixelOrder() SubpixelOrder {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return SubpixelOrderDefault
+	}
+	return optionsGetSubpixelOrder(o.ptr)
+}
+
+// SetHintStyle sets how strongly glyph outlines are fitted to the pixel grid.
+func (o *Options) SetHintStyle(style HintStyle) {
+	o.withLock(func() {
+		optionsSetHintStyle(o.ptr, style)
+	})
+}
+
+// GetHintStyle returns the hint style.
+func (o *Options) GetHintStyle() HintStyle {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return HintStyleDefault
+	}
+	return optionsGetHintStyle(o.ptr)
+}
+
+// SetHintMetrics sets whether font metrics are rounded to integer
+// device-space values. Hinted metrics keep glyph spacing crisp on raster
+// output; unhinted metrics keep layout independent of resolution, which is
+// usually preferred for PDF and SVG output.
+func (o *Options) SetHintMetrics(metrics HintMetrics) {
+	o.withLock(func() {
+		optionsSetHintMetrics(o.ptr, metrics)
+	})
+}
+
+// GetHintMetrics returns the hint metrics mode.
+func (o *Options) GetHintMetrics() HintMetrics {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return HintMetricsDefault
+	}
+	return optionsGetHintMetrics(o.ptr)
+}
+
+// SetColorMode sets whether color fonts are rendered in color.
+func (o *Options) SetColorMode(mode ColorMode) {
+	o.withLock(func() {
+		optionsSetColorMode(o.ptr, mode)
+	})
+}
+
+// GetColorMode returns the color mode.
+func (o *Options) GetColorMode() ColorMode {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return ColorModeDefault
+	}
+	return optionsGetColorMode(o.ptr)
+}
+
+// SetColorPalette selects the palette of a color font to render with. If
+// the font has no palette with the given index, the default palette is used.
+func (o *Options) SetColorPalette(index uint) {
+	o.withLock(func() {
+		optionsSetColorPalette(o.ptr, index)
+	})
+}
+
+// GetColorPalette returns the index of the color palette to render with.
+func (o *Options) GetColorPalette() uint {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return ColorPaletteDefault
+	}
+	return optionsGetColorPalette(o.ptr)
+}
+
+// SetVariations sets the OpenType font variations for variable fonts, as a
+// comma-separated list of axis assignments such as "wght=600,wdth=85". An
+// empty string clears the variations.
+func (o *Options) SetVariations(variations string) {
+	o.withLock(func() {
+		optionsSetVariations(o.ptr, variations)
+	})
+}
+
+// GetVariations returns the OpenType font variations, or an empty string if
+// none are set.
+func (o *Options) GetVariations() string {
+	o.RLock()
+	defer o.RUnlock()
+
+	if o.ptr == nil {
+		return ""
+	}
+	return optionsGetVariations(o.ptr)
+}
+
+func (o *Options) withLock(fn func()) {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.ptr == nil {
+		return
+	}
+	fn()
+}
+
+func (o *Options) close() error {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.ptr != nil {
+		optionsClose(o.ptr)
+		runtime.SetFinalizer(o, nil)
+		o.ptr = nil
+	}
+
+	return nil
+}
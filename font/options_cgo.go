@@ -0,0 +1,108 @@
+package font
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+type OptionsPtr *C.cairo_font_options_t
+
+func optionsCreate() OptionsPtr {
+	return OptionsPtr(C.cairo_font_options_create())
+}
+
+func optionsCopy(ptr OptionsPtr) OptionsPtr {
+	return OptionsPtr(C.cairo_font_options_copy(ptr))
+}
+
+func optionsClose(ptr OptionsPtr) {
+	C.cairo_font_options_destroy(ptr)
+}
+
+func optionsStatus(ptr OptionsPtr) status.Status {
+	return status.Status(C.cairo_font_options_status(ptr))
+}
+
+func optionsMerge(ptr, other OptionsPtr) {
+	C.cairo_font_options_merge(ptr, other)
+}
+
+func optionsEqual(ptr, other OptionsPtr) bool {
+	return C.cairo_font_options_equal(ptr, other) != 0
+}
+
+func optionsHash(ptr OptionsPtr) uint64 {
+	return uint64(C.cairo_font_options_hash(ptr))
+}
+
+func optionsSetAntialias(ptr OptionsPtr, antialias Antialias) {
+	C.cairo_font_options_set_antialias(ptr, C.cairo_antialias_t(antialias))
+}
+
+func optionsGetAntialias(ptr OptionsPtr) Antialias {
+	return Antialias(C.cairo_font_options_get_antialias(ptr))
+}
+
+func optionsSetSubpixelOrder(ptr OptionsPtr, order SubpixelOrder) {
+	C.cairo_font_options_set_subpixel_order(ptr, C.cairo_subpixel_order_t(order))
+}
+
+func optionsGetSubpixelOrder(ptr OptionsPtr) SubpixelOrder {
+	return SubpixelOrder(C.cairo_font_options_get_subpixel_order(ptr))
+}
+
+func optionsSetHintStyle(ptr OptionsPtr, style HintStyle) {
+	C.cairo_font_options_set_hint_style(ptr, C.cairo_hint_style_t(style))
+}
+
+func optionsGetHintStyle(ptr OptionsPtr) HintStyle {
+	return HintStyle(C.cairo_font_options_get_hint_style(ptr))
+}
+
+func optionsSetHintMetrics(ptr OptionsPtr, metrics HintMetrics) {
+	C.cairo_font_options_set_hint_metrics(ptr, C.cairo_hint_metrics_t(metrics))
+}
+
+func optionsGetHintMetrics(ptr OptionsPtr) HintMetrics {
+	return HintMetrics(C.cairo_font_options_get_hint_metrics(ptr))
+}
+
+func optionsSetColorMode(ptr OptionsPtr, mode ColorMode) {
+	C.cairo_font_options_set_color_mode(ptr, C.cairo_color_mode_t(mode))
+}
+
+func optionsGetColorMode(ptr OptionsPtr) ColorMode {
+	return ColorMode(C.cairo_font_options_get_color_mode(ptr))
+}
+
+func optionsSetColorPalette(ptr OptionsPtr, index uint) {
+	C.cairo_font_options_set_color_palette(ptr, C.uint(index))
+}
+
+func optionsGetColorPalette(ptr OptionsPtr) uint {
+	return uint(C.cairo_font_options_get_color_palette(ptr))
+}
+
+func optionsSetVariations(ptr OptionsPtr, variations string) {
+	if variations == "" {
+		C.cairo_font_options_set_variations(ptr, nil)
+		return
+	}
+	cVariations := C.CString(variations)
+	defer C.free(unsafe.Pointer(cVariations))
+	C.cairo_font_options_set_variations(ptr, cVariations)
+}
+
+func optionsGetVariations(ptr OptionsPtr) string {
+	cVariations := C.cairo_font_options_get_variations(ptr)
+	if cVariations == nil {
+		return ""
+	}
+	return C.GoString(cVariations)
+}
@@ -0,0 +1,162 @@
+// ABOUTME: Tests for Options: defaults, setters and getters, Copy, Merge, Equal and Hash,
+// ABOUTME: scaled fonts created with options, and behavior after Close.
+
+package font
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestOptions returns new default font options closed at the end of the test.
+func newTestOptions(t *testing.T) *Options {
+	t.Helper()
+
+	opts, err := NewOptions()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = opts.Close()
+	})
+	return opts
+}
+
+// TestNewOptionsDefaults verifies every option starts at its default.
+func TestNewOptionsDefaults(t *testing.T) {
+	opts := newTestOptions(t)
+
+	assert.Equal(t, status.Success, opts.Status())
+	assert.Equal(t, AntialiasDefault, opts.GetAntialias())
+	assert.Equal(t, SubpixelOrderDefault, opts.GetSubpixelOrder())
+	assert.Equal(t, HintStyleDefault, opts.GetHintStyle())
+	assert.Equal(t, HintMetricsDefault, opts.GetHintMetrics())
+	assert.Equal(t, ColorModeDefault, opts.GetColorMode())
+	assert.Equal(t, uint(ColorPaletteDefault), opts.GetColorPalette())
+	assert.Equal(t, "", opts.GetVariations())
+}
+
+// TestOptionsSetters verifies each option round-trips through its getter.
+func TestOptionsSetters(t *testing.T) {
+	opts := newTestOptions(t)
+
+	opts.SetAntialias(AntialiasSubpixel)
+	opts.SetSubpixelOrder(SubpixelOrderBGR)
+	opts.SetHintStyle(HintStyleSlight)
+	opts.SetHintMetrics(HintMetricsOff)
+	opts.SetColorMode(ColorModeNoColor)
+	opts.SetColorPalette(2)
+	opts.SetVariations("wght=600,wdth=85")
+
+	assert.Equal(t, AntialiasSubpixel, opts.GetAntialias())
+	assert.Equal(t, SubpixelOrderBGR, opts.GetSubpixelOrder())
+	assert.Equal(t, HintStyleSlight, opts.GetHintStyle())
+	assert.Equal(t, HintMetricsOff, opts.GetHintMetrics())
+	assert.Equal(t, ColorModeNoColor, opts.GetColorMode())
+	assert.Equal(t, uint(2), opts.GetColorPalette())
+	assert.Equal(t, "wght=600,wdth=85", opts.GetVariations())
+
+	opts.SetVariations("")
+	assert.Equal(t, "", opts.GetVariations())
+}
+
+// TestOptionsCopyEqualHash verifies copies are equal, independent and hash alike.
+func TestOptionsCopyEqualHash(t *testing.T) {
+	opts := newTestOptions(t)
+	opts.SetAntialias(AntialiasGray)
+	opts.SetHintStyle(HintStyleFull)
+
+	dup, err := opts.Copy()
+	require.NoError(t, err)
+	defer func() {
+		_ = dup.Close()
+	}()
+
+	assert.True(t, opts.Equal(dup))
+	assert.True(t, dup.Equal(opts))
+	assert.True(t, opts.Equal(opts))
+	assert.Equal(t, opts.Hash(), dup.Hash())
+
+	dup.SetHintStyle(HintStyleNone)
+	assert.False(t, opts.Equal(dup))
+	assert.Equal(t, HintStyleFull, opts.GetHintStyle(), "the original should be unchanged")
+	assert.NotEqual(t, opts.Hash(), dup.Hash())
+
+	assert.False(t, opts.Equal(nil))
+}
+
+// TestOptionsMerge verifies only non-default options are merged.
+func TestOptionsMerge(t *testing.T) {
+	base := newTestOptions(t)
+	base.SetAntialias(AntialiasGray)
+	base.SetHintStyle(HintStyleFull)
+
+	override := newTestOptions(t)
+	override.SetHintStyle(HintStyleNone)
+	override.SetHintMetrics(HintMetricsOn)
+
+	base.Merge(override)
+	assert.Equal(t, AntialiasGray, base.GetAntialias(), "default options should not override")
+	assert.Equal(t, HintStyleNone, base.GetHintStyle())
+	assert.Equal(t, HintMetricsOn, base.GetHintMetrics())
+
+	base.Merge(nil)
+	base.Merge(base)
+	assert.Equal(t, status.Success, base.Status())
+}
+
+// TestScaledFontWithOptions verifies scaled fonts keep the options they were
+// created with.
+func TestScaledFontWithOptions(t *testing.T) {
+	face, err := NewToyFace("sans-serif", SlantNormal, WeightNormal)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	opts := newTestOptions(t)
+	opts.SetHintMetrics(HintMetricsOff)
+	opts.SetAntialias(AntialiasNone)
+
+	sf, err := NewScaledFontWithOptions(face, matrix.NewScalingMatrix(12, 12), matrix.NewIdentityMatrix(), opts)
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	got, err := sf.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = got.Close()
+	}()
+	assert.Equal(t, HintMetricsOff, got.GetHintMetrics())
+	assert.Equal(t, AntialiasNone, got.GetAntialias())
+
+	require.NoError(t, opts.Close())
+	_, err = NewScaledFontWithOptions(face, matrix.NewScalingMatrix(12, 12), matrix.NewIdentityMatrix(), opts)
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestOptionsClosed verifies methods on closed options are safe.
+func TestOptionsClosed(t *testing.T) {
+	opts := newTestOptions(t)
+	other := newTestOptions(t)
+	require.NoError(t, opts.Close())
+
+	opts.SetAntialias(AntialiasBest)
+	opts.SetVariations("wght=700")
+	opts.Merge(other)
+
+	assert.Equal(t, status.NullPointer, opts.Status())
+	assert.Equal(t, AntialiasDefault, opts.GetAntialias())
+	assert.Equal(t, "", opts.GetVariations())
+	assert.Equal(t, uint64(0), opts.Hash())
+	assert.False(t, opts.Equal(other))
+	assert.False(t, other.Equal(opts))
+	assert.False(t, opts.Equal(opts))
+
+	_, err := opts.Copy()
+	assert.Equal(t, status.NullPointer, err)
+}
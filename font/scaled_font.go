@@ -0,0 +1,322 @@
+// ABOUTME: ScaledFont wraps cairo_scaled_font_t, a font face at a specific size and transformation.
+// ABOUTME: Provides text-to-glyph conversion, font and glyph metrics, and access to the font matrices.
+
+package font
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+)
+
+// ScaledFont represents a font face at a particular size and
+// transformation, ready for measuring and laying out glyphs.
+//
+// A scaled font is described by a font matrix, which maps font space to user
+// space (a scaling matrix of the font size for untransformed text), and a
+// CTM, which maps user space to device space. Together they determine the
+// size at which glyphs are rasterized and hinted.
+//
+// Use [ScaledFont.TextToGlyphs] to turn text into positioned glyphs, adjust
+// the glyph positions as needed (for kerning tweaks, justification or text
+// on a path), and draw them with Context.ShowGlyphs or Context.GlyphPath.
+//
+// ScaledFont is safe for concurrent use. Methods on a closed ScaledFont
+// have no effect and return zero values or status.NullPointer.
+type ScaledFont struct {
+	sync.RWMutex
+	ptr ScaledFontPtr
+}
+
+func newScaledFont(ptr ScaledFontPtr) *ScaledFont {
+	sf := &ScaledFont{ptr: ptr}
+	runtime.SetFinalizer(sf, (*ScaledFont).close)
+	return sf
+}
+
+// NewScaledFont creates a scaled font from face with the given font matrix
+// and CTM, using default font options. It is equivalent to
+// [NewScaledFontWithOptions] with nil options.
+//
+// Returns status.NullPointer if face, fontMatrix or ctm is nil or face has
+// been closed, or the Cairo error (such as status.InvalidMatrix for a
+// non-invertible matrix) if the scaled font cannot be created.
+//
+// Example:
+//
+//	face, _ := font.NewToyFace("serif", font.SlantNormal, font.WeightNormal)
+//	defer face.Close()
+//
+//	sf, err := font.NewScaledFont(face, matrix.NewScalingMatrix(24, 24), matrix.NewIdentityMatrix())
+//	if err != nil {
+//	    return err
+//	}
+//	defer sf.Close()
+func NewScaledFont(face *Face, fontMatrix, ctm *matrix.Matrix) (*ScaledFont, error) {
+	return NewScaledFontWithOptions(face, fontMatrix, ctm, nil)
+}
+
+// NewScaledFontWithOptions creates a scaled font from face with the given
+// font matrix, CTM and font options. If opts is nil, default options are
+// used. The options are copied, so opts may be changed or closed afterwards.
+//
+// Returns status.NullPointer if face, fontMatrix or ctm is nil, or if face
+// or opts has been closed, or the Cairo error if the scaled font cannot be
+// created.
+func NewScaledFontWithOptions(face *Face, fontMatrix, ctm *matrix.Matrix, opts *Options) (*ScaledFont, error) {
+	if face == nil || fontMatrix == nil || ctm == nil {
+		return nil, status.NullPointer
+	}
+
+	var optsPtr unsafe.Pointer
+	if opts != nil {
+		if optsPtr = opts.Ptr(); optsPtr == nil {
+			return nil, status.NullPointer
+		}
+	}
+
+	face.RLock()
+	defer face.RUnlock()
+
+	if face.ptr == nil {
+		return nil, status.NullPointer
+	}
+
+	ptr := scaledFontCreate(face.ptr, fontMatrix.Ptr(), ctm.Ptr(), OptionsPtr(optsPtr))
+	if st := scaledFontStatus(ptr); st != status.Success {
+		scaledFontClose(ptr)
+		return nil, st
+	}
+	return newScaledFont(ptr), nil
+}
+
+// ScaledFontFromC wraps a C cairo_scaled_font_t pointer.
+//
+// This function is primarily used internally when retrieving scaled fonts
+// from Cairo C API functions (e.g., cairo_get_scaled_font). The returned
+// ScaledFont takes ownership of one reference to the C scaled font, so
+// callers must reference a borrowed pointer before wrapping it.
+func ScaledFontFromC(ptr unsafe.Pointer) *ScaledFont {
+	return newScaledFont(ScaledFontPtr(ptr))
+}
+
+// Ptr returns the underlying pointer for the scaled font.
+func (sf *ScaledFont) Ptr() unsafe.Pointer {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	return unsafe.Pointer(sf.ptr) //nolint:gosec
+}
+
+// Close releases the scaled font.
+func (sf *ScaledFont) Close() error {
+	return sf.close()
+}
+
+// Status returns the status of the scaled font, or status.NullPointer if it
+// has been closed.
+func (sf *ScaledFont) Status() status.Status {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return status.NullPointer
+	}
+	return scaledFontStatus(sf.ptr)
+}
+
+// GetType returns the font backend the scaled font was created with. It
+// returns FontTypeToy if the scaled font has been closed.
+func (sf *ScaledFont) GetType() FontType {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return FontTypeToy
+	}
+	return scaledFontGetType(sf.ptr)
+}
+
+// Extents returns the metrics of the scaled font. The values are in user
+// space, as defined by the font matrix; the CTM only affects hinting.
+//
+// If the scaled font has been closed, Extents returns a zero-value
+// [FontExtents].
+func (sf *ScaledFont) Extents() FontExtents {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return FontExtents{}
+	}
+	return scaledFontExtents(sf.ptr)
+}
+
+// TextExtents measures text as it would be drawn with this scaled font. The
+// result is in user space.
+//
+// If the scaled font has been closed, TextExtents returns a zero-value
+// [TextExtents].
+func (sf *ScaledFont) TextExtents(text string) TextExtents {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return TextExtents{}
+	}
+	return scaledFontTextExtents(sf.ptr, text)
+}
+
+// GlyphExtents measures glyphs, taking their positions into account. The
+// result is in user space, and the advance is measured from the first
+// glyph's origin to the point after the last glyph.
+//
+// If glyphs is empty or the scaled font has been closed, GlyphExtents
+// returns a zero-value [TextExtents].
+func (sf *ScaledFont) GlyphExtents(glyphs []Glyph) TextExtents {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil || len(glyphs) == 0 {
+		return TextExtents{}
+	}
+	return scaledFontGlyphExtents(sf.ptr, glyphs)
+}
+
+// TextToGlyphs converts UTF-8 text into glyphs positioned as this scaled
+// font would lay them out, starting with the first glyph's origin at
+// (x, y). The glyphs can be repositioned and then drawn with
+// Context.ShowGlyphs.
+//
+// Returns status.NullPointer if the scaled font has been closed, or the
+// Cairo error (such as status.InvalidString for malformed UTF-8).
+//
+// Example:
+//
+//	glyphs, err := sf.TextToGlyphs(10, 50, "Hello")
+//	if err != nil {
+//	    return err
+//	}
+//	for i := range glyphs {
+//	    glyphs[i].X += float64(i) * 2 // letter-spacing
+//	}
+//	ctx.ShowGlyphs(glyphs)
+func (sf *ScaledFont) TextToGlyphs(x, y float64, text string) ([]Glyph, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return scaledFontTextToGlyphs(sf.ptr, x, y, text)
+}
+
+// TextToGlyphsWithClusters is like [ScaledFont.TextToGlyphs] but also
+// returns the clusters that map the glyphs back to text, and the flags that
+// describe them. Pass all three to Context.ShowTextGlyphs so that PDF and
+// SVG output keep the text searchable and copyable.
+//
+// Returns status.NullPointer if the scaled font has been closed, or the
+// Cairo error (such as status.InvalidString for malformed UTF-8).
+//
+// Example:
+//
+//	glyphs, clusters, flags, err := sf.TextToGlyphsWithClusters(10, 50, "Invoice #42")
+//	if err != nil {
+//	    return err
+//	}
+//	err = ctx.ShowTextGlyphs("Invoice #42", glyphs, clusters, flags)
+func (sf *ScaledFont) TextToGlyphsWithClusters(x, y float64, text string) ([]Glyph, []TextCluster, TextClusterFlags, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, nil, TextClusterFlagsNone, status.NullPointer
+	}
+	return scaledFontTextToGlyphsWithClusters(sf.ptr, x, y, text)
+}
+
+// GetFontMatrix returns the font matrix the scaled font was created with.
+//
+// Returns status.NullPointer if the scaled font has been closed.
+func (sf *ScaledFont) GetFontMatrix() (*matrix.Matrix, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return scaledFontGetFontMatrix(sf.ptr), nil
+}
+
+// GetCTM returns the CTM the scaled font was created with.
+//
+// Returns status.NullPointer if the scaled font has been closed.
+func (sf *ScaledFont) GetCTM() (*matrix.Matrix, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return scaledFontGetCTM(sf.ptr), nil
+}
+
+// GetScaleMatrix returns the scale matrix of the scaled font: the font
+// matrix multiplied by the CTM, with the translation removed. It maps font
+// space to device space.
+//
+// Returns status.NullPointer if the scaled font has been closed.
+func (sf *ScaledFont) GetScaleMatrix() (*matrix.Matrix, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return scaledFontGetScaleMatrix(sf.ptr), nil
+}
+
+// GetFontFace returns the font face the scaled font was created from. The
+// returned Face holds its own reference and should be closed by the caller.
+//
+// Returns status.NullPointer if the scaled font has been closed.
+func (sf *ScaledFont) GetFontFace() (*Face, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return newFace(scaledFontGetFontFace(sf.ptr)), nil
+}
+
+// GetFontOptions returns a copy of the font options the scaled font was
+// created with. The returned Options should be closed by the caller.
+//
+// Returns status.NullPointer if the scaled font has been closed.
+func (sf *ScaledFont) GetFontOptions() (*Options, error) {
+	sf.RLock()
+	defer sf.RUnlock()
+
+	if sf.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return newOptions(scaledFontGetFontOptions(sf.ptr)), nil
+}
+
+func (sf *ScaledFont) close() error {
+	sf.Lock()
+	defer sf.Unlock()
+
+	if sf.ptr != nil {
+		scaledFontClose(sf.ptr)
+		runtime.SetFinalizer(sf, nil)
+		sf.ptr = nil
+	}
+
+	return nil
+}
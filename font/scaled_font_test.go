@@ -0,0 +1,219 @@
+// ABOUTME: Tests for Face and ScaledFont: construction, metrics, text-to-glyph conversion,
+// ABOUTME: matrix accessors, and behavior after Close.
+
+package font
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestScaledFont returns a 20-unit sans-serif scaled font with an identity CTM.
+func newTestScaledFont(t *testing.T) *ScaledFont {
+	t.Helper()
+
+	face, err := NewToyFace("sans-serif", SlantNormal, WeightNormal)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	sf, err := NewScaledFont(face, matrix.NewScalingMatrix(20, 20), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sf.Close()
+	})
+	return sf
+}
+
+// TestNewToyFace verifies toy faces are created with the toy font type.
+func TestNewToyFace(t *testing.T) {
+	face, err := NewToyFace("serif", SlantItalic, WeightBold)
+	require.NoError(t, err)
+
+	assert.Equal(t, status.Success, face.Status())
+	assert.Equal(t, FontTypeToy, face.GetType())
+	assert.NotNil(t, face.Ptr())
+
+	require.NoError(t, face.Close())
+	assert.Equal(t, status.NullPointer, face.Status())
+	assert.Nil(t, face.Ptr())
+	assert.NoError(t, face.Close(), "closing twice should be safe")
+}
+
+// TestNewScaledFont tests creation of scaled fonts and argument validation.
+func TestNewScaledFont(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		sf := newTestScaledFont(t)
+		assert.Equal(t, status.Success, sf.Status())
+		assert.Equal(t, FontTypeToy, sf.GetType())
+	})
+
+	t.Run("nil_arguments", func(t *testing.T) {
+		face, err := NewToyFace("serif", SlantNormal, WeightNormal)
+		require.NoError(t, err)
+		defer func() {
+			_ = face.Close()
+		}()
+
+		_, err = NewScaledFont(nil, matrix.NewIdentityMatrix(), matrix.NewIdentityMatrix())
+		assert.Equal(t, status.NullPointer, err)
+		_, err = NewScaledFont(face, nil, matrix.NewIdentityMatrix())
+		assert.Equal(t, status.NullPointer, err)
+		_, err = NewScaledFont(face, matrix.NewIdentityMatrix(), nil)
+		assert.Equal(t, status.NullPointer, err)
+	})
+
+	t.Run("closed_face", func(t *testing.T) {
+		face, err := NewToyFace("serif", SlantNormal, WeightNormal)
+		require.NoError(t, err)
+		require.NoError(t, face.Close())
+
+		sf, err := NewScaledFont(face, matrix.NewIdentityMatrix(), matrix.NewIdentityMatrix())
+		assert.Nil(t, sf)
+		assert.Equal(t, status.NullPointer, err)
+	})
+
+	t.Run("singular_matrix", func(t *testing.T) {
+		face, err := NewToyFace("serif", SlantNormal, WeightNormal)
+		require.NoError(t, err)
+		defer func() {
+			_ = face.Close()
+		}()
+
+		sf, err := NewScaledFont(face, matrix.NewScalingMatrix(0, 0), matrix.NewIdentityMatrix())
+		assert.Nil(t, sf)
+		assert.Equal(t, status.InvalidMatrix, err)
+	})
+}
+
+// TestScaledFontMetrics verifies font, text and glyph extents are consistent.
+func TestScaledFontMetrics(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	fe := sf.Extents()
+	assert.Greater(t, fe.Ascent, 0.0)
+	assert.Greater(t, fe.Height, 0.0)
+
+	te := sf.TextExtents("Hello")
+	assert.Greater(t, te.Width, 0.0)
+	assert.Greater(t, te.XAdvance, 0.0)
+
+	glyphs, err := sf.TextToGlyphs(0, 0, "Hello")
+	require.NoError(t, err)
+	ge := sf.GlyphExtents(glyphs)
+	assert.InDelta(t, te.XAdvance, ge.XAdvance, 1e-9)
+	assert.InDelta(t, te.Width, ge.Width, 1e-9)
+
+	assert.Equal(t, TextExtents{}, sf.GlyphExtents(nil))
+}
+
+// TestScaledFontTextToGlyphs verifies glyphs start at the given origin and
+// advance left to right.
+func TestScaledFontTextToGlyphs(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	glyphs, err := sf.TextToGlyphs(10, 30, "abc")
+	require.NoError(t, err)
+	require.Len(t, glyphs, 3)
+
+	assert.Equal(t, 10.0, glyphs[0].X)
+	assert.Equal(t, 30.0, glyphs[0].Y)
+	for i := 1; i < len(glyphs); i++ {
+		assert.Greater(t, glyphs[i].X, glyphs[i-1].X)
+		assert.Equal(t, 30.0, glyphs[i].Y)
+	}
+	assert.NotEqual(t, glyphs[0].Index, glyphs[1].Index)
+
+	glyphs, err = sf.TextToGlyphs(0, 0, "")
+	require.NoError(t, err)
+	assert.Empty(t, glyphs)
+
+	_, err = sf.TextToGlyphs(0, 0, "\xff\xfe")
+	assert.Equal(t, status.InvalidString, err)
+}
+
+// TestScaledFontTextToGlyphsWithClusters verifies the clusters map the glyphs
+// back to the text and match the glyphs from TextToGlyphs.
+func TestScaledFontTextToGlyphsWithClusters(t *testing.T) {
+	sf := newTestScaledFont(t)
+	text := "naïve"
+
+	glyphs, clusters, flags, err := sf.TextToGlyphsWithClusters(10, 30, text)
+	require.NoError(t, err)
+	assert.Equal(t, TextClusterFlagsNone, flags)
+	require.NoError(t, ValidateTextClusters(text, len(glyphs), clusters))
+
+	plain, err := sf.TextToGlyphs(10, 30, text)
+	require.NoError(t, err)
+	assert.Equal(t, plain, glyphs)
+
+	_, _, _, err = sf.TextToGlyphsWithClusters(0, 0, "\xff\xfe")
+	assert.Equal(t, status.InvalidString, err)
+
+	require.NoError(t, sf.Close())
+	_, _, _, err = sf.TextToGlyphsWithClusters(0, 0, text)
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestScaledFontMatrices verifies the font matrix, CTM and scale matrix.
+func TestScaledFontMatrices(t *testing.T) {
+	face, err := NewToyFace("serif", SlantNormal, WeightNormal)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	sf, err := NewScaledFont(face, matrix.NewScalingMatrix(12, 12), matrix.NewScalingMatrix(2, 2))
+	require.NoError(t, err)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	fm, err := sf.GetFontMatrix()
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, fm.XX)
+	assert.Equal(t, 12.0, fm.YY)
+
+	ctm, err := sf.GetCTM()
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, ctm.XX)
+
+	scale, err := sf.GetScaleMatrix()
+	require.NoError(t, err)
+	assert.Equal(t, 24.0, scale.XX)
+	assert.Equal(t, 24.0, scale.YY)
+
+	got, err := sf.GetFontFace()
+	require.NoError(t, err)
+	defer func() {
+		_ = got.Close()
+	}()
+	assert.Equal(t, face.Ptr(), got.Ptr())
+}
+
+// TestScaledFontClosed verifies methods on a closed scaled font are safe.
+func TestScaledFontClosed(t *testing.T) {
+	sf := newTestScaledFont(t)
+	require.NoError(t, sf.Close())
+
+	assert.Equal(t, status.NullPointer, sf.Status())
+	assert.Equal(t, FontExtents{}, sf.Extents())
+	assert.Equal(t, TextExtents{}, sf.TextExtents("Hello"))
+	assert.Equal(t, TextExtents{}, sf.GlyphExtents([]Glyph{{Index: 1}}))
+
+	_, err := sf.TextToGlyphs(0, 0, "Hello")
+	assert.Equal(t, status.NullPointer, err)
+	_, err = sf.GetFontMatrix()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = sf.GetCTM()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = sf.GetScaleMatrix()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = sf.GetFontFace()
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,30 @@
+// ABOUTME: Package userfont implements Cairo user fonts, whose glyphs are drawn by Go callbacks.
+// ABOUTME: It lives apart from package font because its callbacks draw with a context.Context.
+
+// Package userfont creates Cairo user fonts: font faces whose glyphs are
+// drawn by Go code instead of being loaded from a font file.
+//
+// A user font face is an ordinary [font.Face]. Select it with
+// Context.SetFontFace and draw text with ShowText, TextPath or ShowGlyphs as
+// with any other face. Cairo calls [Funcs.RenderGlyph] once per glyph and
+// scaled font, recording the drawing and replaying it wherever the glyph is
+// shown, so icon fonts and pictogram sets defined as vector paths in Go get
+// caching, hinting and text layout for free.
+//
+// # Font Space
+//
+// Callbacks work in font space, where the font size is 1: a glyph drawn
+// from (0, 0) to (1, -1) fills one em above the baseline. Cairo scales the
+// result to the font size and transformation in effect when the text is
+// shown.
+//
+// # Errors
+//
+// If a callback returns an error or panics, the panic is recovered and the
+// scaled font enters an error state, which is reported by the Status of the
+// context that was drawing. Return status.UserFontNotImplemented from
+// TextToGlyphs or UnicodeToGlyph to fall back to Cairo's default behavior.
+//
+// This package is separate from package font because the callbacks receive
+// a *context.Context, and package context itself depends on package font.
+package userfont
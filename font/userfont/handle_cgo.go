@@ -0,0 +1,42 @@
+// ABOUTME: Exported callbacks that Cairo invokes to serve user font faces from Go state.
+// ABOUTME: Exports the init, render, text-to-glyphs and unicode-to-glyph callbacks, and the face release hook.
+
+package userfont
+
+// #include <cairo.h>
+// #include <stdint.h>
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+//export goUserFontInit
+func goUserFontInit(h C.uintptr_t, sf *C.cairo_scaled_font_t, cr *C.cairo_t, extents *C.cairo_font_extents_t) C.cairo_status_t {
+	return userFontInit(h, sf, cr, extents)
+}
+
+//export goUserFontRenderGlyph
+func goUserFontRenderGlyph(h C.uintptr_t, sf *C.cairo_scaled_font_t, glyph C.ulong, cr *C.cairo_t, extents *C.cairo_text_extents_t) C.cairo_status_t {
+	return userFontRenderGlyph(h, sf, glyph, cr, extents)
+}
+
+//export goUserFontTextToGlyphs
+func goUserFontTextToGlyphs(
+	h C.uintptr_t, sf *C.cairo_scaled_font_t, utf8 *C.char, utf8Len C.int,
+	glyphs **C.cairo_glyph_t, numGlyphs *C.int,
+	clusters **C.cairo_text_cluster_t, numClusters *C.int,
+) C.cairo_status_t {
+	return userFontTextToGlyphs(h, sf, utf8, utf8Len, glyphs, numGlyphs, clusters, numClusters)
+}
+
+//export goUserFontUnicodeToGlyph
+func goUserFontUnicodeToGlyph(h C.uintptr_t, sf *C.cairo_scaled_font_t, unicode C.ulong, glyph *C.ulong) C.cairo_status_t {
+	return userFontUnicodeToGlyph(h, sf, unicode, glyph)
+}
+
+//export goUserFontRelease
+func goUserFontRelease(data unsafe.Pointer) {
+	cgo.Handle(uintptr(data)).Delete()
+}
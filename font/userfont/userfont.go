@@ -0,0 +1,182 @@
+// ABOUTME: Defines the Funcs callbacks of a user font and NewFace, which creates the font face.
+// ABOUTME: Converts callback errors and panics into Cairo statuses and builds glyph clusters for text.
+
+package userfont
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+)
+
+// Funcs holds the callbacks that implement a user font. Only RenderGlyph is
+// required.
+//
+// Every callback receives the scaled font it is working for. The scaled
+// font and any context passed to a callback are only valid for the duration
+// of the call and must not be retained.
+//
+// Callbacks run while Cairo is in the middle of a text operation on another
+// context. They must not draw with that context.
+type Funcs struct {
+	// Init is called once when a scaled font is created from the face. It
+	// may set the font extents, in font space; by default the ascent and
+	// height are 1 and the descent is 0. ctx is set up for font space and
+	// can be used for measuring; drawing on it has no effect. Init may be
+	// nil.
+	Init func(sf *font.ScaledFont, ctx *context.Context, extents *font.FontExtents) error
+
+	// RenderGlyph draws glyph with ctx, which targets a recording surface
+	// and is set up for font space. It should set extents.XAdvance (and
+	// extents.YAdvance for vertical text); the ink extents are computed by
+	// Cairo from the drawing. The source of ctx is the color the text is
+	// shown in, so glyphs should not set a source of their own.
+	RenderGlyph func(sf *font.ScaledFont, glyph uint64, ctx *context.Context, extents *font.TextExtents) error
+
+	// TextToGlyphs converts UTF-8 text into glyphs positioned in font space
+	// as if the text were shown at the origin. This allows ligatures and
+	// kerning. If the number of glyphs equals the number of runes in text,
+	// each glyph is mapped back to its rune for PDF text extraction;
+	// otherwise the text maps to the glyphs as a single cluster.
+	// TextToGlyphs may be nil, in which case UnicodeToGlyph is used.
+	TextToGlyphs func(sf *font.ScaledFont, text string) ([]font.Glyph, error)
+
+	// UnicodeToGlyph returns the glyph for a rune. If it is nil, the glyph
+	// index is the rune's code point.
+	UnicodeToGlyph func(sf *font.ScaledFont, r rune) (uint64, error)
+}
+
+// NewFace creates a user font face whose glyphs are drawn by funcs.
+//
+// The callbacks are kept alive for as long as Cairo holds a reference to the
+// face, which may outlive Close if a context or scaled font still uses it.
+//
+// Returns status.NullPointer if funcs.RenderGlyph is nil, or the Cairo error
+// if the face cannot be created.
+//
+// Example:
+//
+//	face, err := userfont.NewFace(userfont.Funcs{
+//		RenderGlyph: func(_ *font.ScaledFont, glyph uint64, ctx *context.Context, ext *font.TextExtents) error {
+//			ctx.Arc(0.5, -0.5, 0.4, 0, 2*math.Pi) // every glyph is a dot
+//			ctx.Fill()
+//			ext.XAdvance = 1
+//			return nil
+//		},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+//
+//	ctx.SetFontFace(face)
+//	ctx.SetFontSize(24)
+//	ctx.ShowText("...")
+func NewFace(funcs Funcs) (*font.Face, error) {
+	if funcs.RenderGlyph == nil {
+		return nil, status.NullPointer
+	}
+
+	ptr, st := userFontCreate(&faceState{funcs: funcs})
+	if st != status.Success {
+		return nil, st
+	}
+	return font.FaceFromC(ptr), nil
+}
+
+// faceState is the Go state behind a user font face. Cairo holds it through
+// a handle attached to the face and releases it when the face is destroyed.
+type faceState struct {
+	funcs Funcs
+}
+
+// statusFor returns the status to report to Cairo for a callback error.
+func statusFor(err error) status.Status {
+	if err == nil {
+		return status.Success
+	}
+	var st status.Status
+	if errors.As(err, &st) {
+		return st
+	}
+	return status.UserFontError
+}
+
+// recoverInto turns a panic in a callback into an error stored in *err.
+func recoverInto(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("user font callback panicked: %v", r)
+	}
+}
+
+func (s *faceState) init(sf *font.ScaledFont, ctx *context.Context, extents *font.FontExtents) status.Status {
+	if s.funcs.Init == nil {
+		return status.Success
+	}
+	return statusFor(s.callInit(sf, ctx, extents))
+}
+
+func (s *faceState) callInit(sf *font.ScaledFont, ctx *context.Context, extents *font.FontExtents) (err error) {
+	defer recoverInto(&err)
+	return s.funcs.Init(sf, ctx, extents)
+}
+
+func (s *faceState) renderGlyph(sf *font.ScaledFont, glyph uint64, ctx *context.Context, extents *font.TextExtents) status.Status {
+	return statusFor(s.callRenderGlyph(sf, glyph, ctx, extents))
+}
+
+func (s *faceState) callRenderGlyph(sf *font.ScaledFont, glyph uint64, ctx *context.Context, extents *font.TextExtents) (err error) {
+	defer recoverInto(&err)
+	return s.funcs.RenderGlyph(sf, glyph, ctx, extents)
+}
+
+// textToGlyphs calls TextToGlyphs and maps the result back to text with
+// clusters.
+func (s *faceState) textToGlyphs(sf *font.ScaledFont, text string) ([]font.Glyph, []font.TextCluster, status.Status) {
+	glyphs, err := s.callTextToGlyphs(sf, text)
+	if err != nil {
+		return nil, nil, statusFor(err)
+	}
+	return glyphs, textClusters(text, len(glyphs)), status.Success
+}
+
+func (s *faceState) callTextToGlyphs(sf *font.ScaledFont, text string) (glyphs []font.Glyph, err error) {
+	defer recoverInto(&err)
+	return s.funcs.TextToGlyphs(sf, text)
+}
+
+// textClusters maps each rune of text to one glyph when there are as many
+// glyphs as runes, and otherwise maps all of text to all of the glyphs.
+func textClusters(text string, numGlyphs int) []font.TextCluster {
+	if text == "" && numGlyphs == 0 {
+		return nil
+	}
+	if utf8.RuneCountInString(text) != numGlyphs {
+		return []font.TextCluster{{NumBytes: len(text), NumGlyphs: numGlyphs}}
+	}
+
+	clusters := make([]font.TextCluster, 0, numGlyphs)
+	for i := 0; i < len(text); {
+		_, n := utf8.DecodeRuneInString(text[i:])
+		clusters = append(clusters, font.TextCluster{NumBytes: n, NumGlyphs: 1})
+		i += n
+	}
+	return clusters
+}
+
+func (s *faceState) unicodeToGlyph(sf *font.ScaledFont, r rune) (uint64, status.Status) {
+	glyph, err := s.callUnicodeToGlyph(sf, r)
+	if err != nil {
+		return 0, statusFor(err)
+	}
+	return glyph, status.Success
+}
+
+func (s *faceState) callUnicodeToGlyph(sf *font.ScaledFont, r rune) (glyph uint64, err error) {
+	defer recoverInto(&err)
+	return s.funcs.UnicodeToGlyph(sf, r)
+}
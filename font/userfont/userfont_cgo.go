@@ -0,0 +1,258 @@
+// ABOUTME: CGO bindings that create Cairo user font faces and route their callbacks to Go.
+// ABOUTME: Converts extents, glyphs and clusters between Cairo's C structs and package font types.
+
+package userfont
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdint.h>
+//
+// extern cairo_status_t goUserFontInit(uintptr_t h, cairo_scaled_font_t *sf, cairo_t *cr, cairo_font_extents_t *extents);
+// extern cairo_status_t goUserFontRenderGlyph(uintptr_t h, cairo_scaled_font_t *sf, unsigned long glyph, cairo_t *cr, cairo_text_extents_t *extents);
+// extern cairo_status_t goUserFontTextToGlyphs(uintptr_t h, cairo_scaled_font_t *sf, char *utf8, int utf8_len,
+//                                              cairo_glyph_t **glyphs, int *num_glyphs,
+//                                              cairo_text_cluster_t **clusters, int *num_clusters);
+// extern cairo_status_t goUserFontUnicodeToGlyph(uintptr_t h, cairo_scaled_font_t *sf, unsigned long unicode, unsigned long *glyph);
+// extern void goUserFontRelease(void *data);
+//
+// static cairo_user_data_key_t _userFontKey;
+//
+// // _userFontHandle returns the handle to the Go state of the user font
+// // face that sf was created from.
+// static uintptr_t _userFontHandle(cairo_scaled_font_t *sf) {
+//     return (uintptr_t)cairo_font_face_get_user_data(cairo_scaled_font_get_font_face(sf), &_userFontKey);
+// }
+//
+// static cairo_status_t _userFontInit(cairo_scaled_font_t *sf, cairo_t *cr, cairo_font_extents_t *extents) {
+//     return goUserFontInit(_userFontHandle(sf), sf, cr, extents);
+// }
+//
+// static cairo_status_t _userFontRenderGlyph(cairo_scaled_font_t *sf, unsigned long glyph,
+//                                            cairo_t *cr, cairo_text_extents_t *extents) {
+//     return goUserFontRenderGlyph(_userFontHandle(sf), sf, glyph, cr, extents);
+// }
+//
+// static cairo_status_t _userFontTextToGlyphs(cairo_scaled_font_t *sf, const char *utf8, int utf8_len,
+//                                             cairo_glyph_t **glyphs, int *num_glyphs,
+//                                             cairo_text_cluster_t **clusters, int *num_clusters,
+//                                             cairo_text_cluster_flags_t *cluster_flags) {
+//     if (cluster_flags != NULL) {
+//         *cluster_flags = 0;
+//     }
+//     return goUserFontTextToGlyphs(_userFontHandle(sf), sf, (char *)utf8, utf8_len,
+//                                   glyphs, num_glyphs, clusters, num_clusters);
+// }
+//
+// static cairo_status_t _userFontUnicodeToGlyph(cairo_scaled_font_t *sf, unsigned long unicode, unsigned long *glyph) {
+//     return goUserFontUnicodeToGlyph(_userFontHandle(sf), sf, unicode, glyph);
+// }
+//
+// // _userFontCreate creates a user font face served by the Go state behind
+// // h. Once attached, the handle is released by goUserFontRelease when the
+// // face is destroyed.
+// static cairo_font_face_t *_userFontCreate(uintptr_t h, int hasInit, int hasTextToGlyphs,
+//                                           int hasUnicodeToGlyph, cairo_status_t *st) {
+//     cairo_font_face_t *face = cairo_user_font_face_create();
+//     *st = cairo_font_face_set_user_data(face, &_userFontKey, (void *)h, goUserFontRelease);
+//     if (*st != CAIRO_STATUS_SUCCESS) {
+//         cairo_font_face_destroy(face);
+//         return NULL;
+//     }
+//
+//     cairo_user_font_face_set_render_glyph_func(face, _userFontRenderGlyph);
+//     if (hasInit) {
+//         cairo_user_font_face_set_init_func(face, _userFontInit);
+//     }
+//     if (hasTextToGlyphs) {
+//         cairo_user_font_face_set_text_to_glyphs_func(face, _userFontTextToGlyphs);
+//     }
+//     if (hasUnicodeToGlyph) {
+//         cairo_user_font_face_set_unicode_to_glyph_func(face, _userFontUnicodeToGlyph);
+//     }
+//     return face;
+// }
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+)
+
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// userFontCreate creates a user font face whose callbacks are served by
+// state.
+func userFontCreate(state *faceState) (unsafe.Pointer, status.Status) {
+	h := cgo.NewHandle(state)
+
+	var st C.cairo_status_t
+	ptr := C._userFontCreate(
+		C.uintptr_t(h),
+		cBool(state.funcs.Init != nil),
+		cBool(state.funcs.TextToGlyphs != nil),
+		cBool(state.funcs.UnicodeToGlyph != nil),
+		&st,
+	)
+	if ptr == nil {
+		h.Delete()
+		return nil, status.Status(st)
+	}
+	return unsafe.Pointer(ptr), status.Success
+}
+
+// stateFor returns the Go state behind handle h.
+func stateFor(h C.uintptr_t) *faceState {
+	state, _ := cgo.Handle(h).Value().(*faceState)
+	return state
+}
+
+// borrowScaledFont wraps a scaled font passed to a callback. The returned
+// ScaledFont holds its own reference and must be closed after the call.
+func borrowScaledFont(sf *C.cairo_scaled_font_t) *font.ScaledFont {
+	C.cairo_scaled_font_reference(sf)
+	return font.ScaledFontFromC(unsafe.Pointer(sf))
+}
+
+// borrowContext wraps a context passed to a callback. The returned Context
+// holds its own reference and must be closed after the call.
+func borrowContext(cr *C.cairo_t) *context.Context {
+	C.cairo_reference(cr)
+	return context.ContextFromC(unsafe.Pointer(cr))
+}
+
+func userFontInit(h C.uintptr_t, csf *C.cairo_scaled_font_t, cr *C.cairo_t, cExtents *C.cairo_font_extents_t) C.cairo_status_t {
+	sf := borrowScaledFont(csf)
+	defer func() {
+		_ = sf.Close()
+	}()
+	ctx := borrowContext(cr)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	extents := font.FontExtents{
+		Ascent:      float64(cExtents.ascent),
+		Descent:     float64(cExtents.descent),
+		Height:      float64(cExtents.height),
+		MaxXAdvance: float64(cExtents.max_x_advance),
+		MaxYAdvance: float64(cExtents.max_y_advance),
+	}
+	st := stateFor(h).init(sf, ctx, &extents)
+
+	cExtents.ascent = C.double(extents.Ascent)
+	cExtents.descent = C.double(extents.Descent)
+	cExtents.height = C.double(extents.Height)
+	cExtents.max_x_advance = C.double(extents.MaxXAdvance)
+	cExtents.max_y_advance = C.double(extents.MaxYAdvance)
+	return C.cairo_status_t(st)
+}
+
+func userFontRenderGlyph(h C.uintptr_t, csf *C.cairo_scaled_font_t, glyph C.ulong, cr *C.cairo_t, cExtents *C.cairo_text_extents_t) C.cairo_status_t {
+	sf := borrowScaledFont(csf)
+	defer func() {
+		_ = sf.Close()
+	}()
+	ctx := borrowContext(cr)
+	defer func() {
+		_ = ctx.Close()
+	}()
+
+	extents := font.TextExtents{
+		XBearing: float64(cExtents.x_bearing),
+		YBearing: float64(cExtents.y_bearing),
+		Width:    float64(cExtents.width),
+		Height:   float64(cExtents.height),
+		XAdvance: float64(cExtents.x_advance),
+		YAdvance: float64(cExtents.y_advance),
+	}
+	st := stateFor(h).renderGlyph(sf, uint64(glyph), ctx, &extents)
+
+	cExtents.x_bearing = C.double(extents.XBearing)
+	cExtents.y_bearing = C.double(extents.YBearing)
+	cExtents.width = C.double(extents.Width)
+	cExtents.height = C.double(extents.Height)
+	cExtents.x_advance = C.double(extents.XAdvance)
+	cExtents.y_advance = C.double(extents.YAdvance)
+	return C.cairo_status_t(st)
+}
+
+// userFontTextToGlyphs fills in the glyph and cluster arrays for Cairo. As
+// Cairo's text_to_glyphs contract requires, arrays too small for the result
+// are replaced with ones from cairo_glyph_allocate and
+// cairo_text_cluster_allocate, which Cairo frees.
+func userFontTextToGlyphs(
+	h C.uintptr_t, csf *C.cairo_scaled_font_t, utf8 *C.char, utf8Len C.int,
+	cGlyphs **C.cairo_glyph_t, numGlyphs *C.int,
+	cClusters **C.cairo_text_cluster_t, numClusters *C.int,
+) C.cairo_status_t {
+	sf := borrowScaledFont(csf)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	glyphs, clusters, st := stateFor(h).textToGlyphs(sf, C.GoStringN(utf8, utf8Len))
+	if st != status.Success {
+		return C.cairo_status_t(st)
+	}
+
+	if *cGlyphs == nil || int(*numGlyphs) < len(glyphs) {
+		*cGlyphs = C.cairo_glyph_allocate(C.int(len(glyphs)))
+		if *cGlyphs == nil && len(glyphs) > 0 {
+			return C.cairo_status_t(status.NoMemory)
+		}
+	}
+	*numGlyphs = C.int(len(glyphs))
+	if len(glyphs) > 0 {
+		out := unsafe.Slice(*cGlyphs, len(glyphs))
+		for i, g := range glyphs {
+			out[i] = C.cairo_glyph_t{
+				index: C.ulong(g.Index),
+				x:     C.double(g.X),
+				y:     C.double(g.Y),
+			}
+		}
+	}
+
+	if cClusters == nil {
+		return C.cairo_status_t(status.Success)
+	}
+	if *cClusters == nil || int(*numClusters) < len(clusters) {
+		*cClusters = C.cairo_text_cluster_allocate(C.int(len(clusters)))
+		if *cClusters == nil && len(clusters) > 0 {
+			return C.cairo_status_t(status.NoMemory)
+		}
+	}
+	*numClusters = C.int(len(clusters))
+	if len(clusters) > 0 {
+		out := unsafe.Slice(*cClusters, len(clusters))
+		for i, c := range clusters {
+			out[i] = C.cairo_text_cluster_t{
+				num_bytes:  C.int(c.NumBytes),
+				num_glyphs: C.int(c.NumGlyphs),
+			}
+		}
+	}
+	return C.cairo_status_t(status.Success)
+}
+
+func userFontUnicodeToGlyph(h C.uintptr_t, csf *C.cairo_scaled_font_t, unicode C.ulong, glyph *C.ulong) C.cairo_status_t {
+	sf := borrowScaledFont(csf)
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	g, st := stateFor(h).unicodeToGlyph(sf, rune(unicode))
+	if st == status.Success {
+		*glyph = C.ulong(g)
+	}
+	return C.cairo_status_t(st)
+}
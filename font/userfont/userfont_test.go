@@ -0,0 +1,266 @@
+// ABOUTME: Tests for user font faces drawn by Go callbacks.
+// ABOUTME: Renders text to image surfaces and checks glyph mapping, errors and recovered panics.
+
+package userfont
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// squareGlyph draws every glyph as a square filling one em above the
+// baseline.
+func squareGlyph(_ *font.ScaledFont, _ uint64, ctx *context.Context, extents *font.TextExtents) error {
+	ctx.Rectangle(0, -1, 1, 1)
+	ctx.Fill()
+	extents.XAdvance = 1
+	return nil
+}
+
+// newTestContext returns a context drawing to a new ImageSurface.
+func newTestContext(t *testing.T, width, height int) (*context.Context, *surface.ImageSurface) {
+	t.Helper()
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, width, height)
+	require.NoError(t, err)
+	ctx, err := context.NewContext(surf)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ctx.Close()
+		_ = surf.Close()
+	})
+	return ctx, surf
+}
+
+// newTestFace creates a user font face from funcs, closed when the test
+// ends.
+func newTestFace(t *testing.T, funcs Funcs) *font.Face {
+	t.Helper()
+	face, err := NewFace(funcs)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = face.Close()
+	})
+	return face
+}
+
+// newTestScaledFont creates a scaled font of the given size from face.
+func newTestScaledFont(t *testing.T, face *font.Face, size float64) *font.ScaledFont {
+	t.Helper()
+	sf, err := font.NewScaledFont(face, matrix.NewScalingMatrix(size, size), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sf.Close()
+	})
+	return sf
+}
+
+// TestNewFace tests creating user font faces.
+func TestNewFace(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		face := newTestFace(t, Funcs{RenderGlyph: squareGlyph})
+		assert.Equal(t, status.Success, face.Status())
+		assert.Equal(t, font.FontTypeUser, face.GetType())
+	})
+
+	t.Run("missing_render_glyph", func(t *testing.T) {
+		face, err := NewFace(Funcs{})
+		assert.Nil(t, face)
+		assert.ErrorIs(t, err, status.NullPointer)
+	})
+}
+
+// TestRenderGlyph tests that glyphs drawn by RenderGlyph are shown in the
+// context's source color at the font size.
+func TestRenderGlyph(t *testing.T) {
+	ctx, surf := newTestContext(t, 60, 30)
+	ctx.SetFontFace(newTestFace(t, Funcs{RenderGlyph: squareGlyph}))
+	ctx.SetFontSize(20)
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.MoveTo(5, 25)
+	ctx.ShowText("ab")
+	require.Equal(t, status.Success, ctx.Status())
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, img.At(15, 15), "inside the first glyph")
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, img.At(35, 15), "inside the second glyph")
+	assert.Equal(t, color.RGBA{}, img.At(50, 15), "past the last glyph")
+	assert.Equal(t, color.RGBA{}, img.At(15, 2), "above the glyphs")
+}
+
+// TestInit tests that the extents set by Init are scaled to the font size.
+func TestInit(t *testing.T) {
+	face := newTestFace(t, Funcs{
+		Init: func(_ *font.ScaledFont, _ *context.Context, extents *font.FontExtents) error {
+			extents.Ascent = 0.75
+			extents.Descent = 0.25
+			extents.Height = 1.5
+			return nil
+		},
+		RenderGlyph: squareGlyph,
+	})
+
+	ext := newTestScaledFont(t, face, 10).Extents()
+	assert.InDelta(t, 7.5, ext.Ascent, 1e-9)
+	assert.InDelta(t, 2.5, ext.Descent, 1e-9)
+	assert.InDelta(t, 15.0, ext.Height, 1e-9)
+}
+
+// TestUnicodeToGlyph tests that runes are mapped to glyphs by
+// UnicodeToGlyph.
+func TestUnicodeToGlyph(t *testing.T) {
+	var rendered []uint64
+	face := newTestFace(t, Funcs{
+		RenderGlyph: func(sf *font.ScaledFont, glyph uint64, ctx *context.Context, extents *font.TextExtents) error {
+			rendered = append(rendered, glyph)
+			return squareGlyph(sf, glyph, ctx, extents)
+		},
+		UnicodeToGlyph: func(_ *font.ScaledFont, r rune) (uint64, error) {
+			return uint64(r - 'a' + 1), nil
+		},
+	})
+
+	glyphs, err := newTestScaledFont(t, face, 10).TextToGlyphs(0, 0, "abc")
+	require.NoError(t, err)
+	require.Len(t, glyphs, 3)
+	for i, g := range glyphs {
+		assert.Equal(t, uint64(i+1), g.Index)
+		assert.InDelta(t, float64(10*i), g.X, 1e-9)
+	}
+	assert.Subset(t, rendered, []uint64{1, 2, 3})
+}
+
+// TestTextToGlyphs tests that glyphs from TextToGlyphs are positioned in
+// font space and scaled to the font size.
+func TestTextToGlyphs(t *testing.T) {
+	face := newTestFace(t, Funcs{
+		RenderGlyph: squareGlyph,
+		TextToGlyphs: func(_ *font.ScaledFont, text string) ([]font.Glyph, error) {
+			// Render "ff" as a single ligature glyph.
+			if text == "ff" {
+				return []font.Glyph{{Index: 100}}, nil
+			}
+			glyphs := make([]font.Glyph, 0, len(text))
+			for i, r := range []rune(text) {
+				glyphs = append(glyphs, font.Glyph{Index: uint64(r), X: 1.5 * float64(i)})
+			}
+			return glyphs, nil
+		},
+	})
+	sf := newTestScaledFont(t, face, 10)
+
+	glyphs, err := sf.TextToGlyphs(2, 3, "ab")
+	require.NoError(t, err)
+	assert.Equal(t, []font.Glyph{{Index: 'a', X: 2, Y: 3}, {Index: 'b', X: 17, Y: 3}}, glyphs)
+
+	glyphs, err = sf.TextToGlyphs(0, 0, "ff")
+	require.NoError(t, err)
+	assert.Equal(t, []font.Glyph{{Index: 100}}, glyphs)
+}
+
+// TestCallbackErrors tests that errors and panics in callbacks put the
+// drawing context into an error state.
+func TestCallbackErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		funcs Funcs
+		want  status.Status
+	}{
+		{
+			name: "render_error",
+			funcs: Funcs{
+				RenderGlyph: func(*font.ScaledFont, uint64, *context.Context, *font.TextExtents) error {
+					return errors.New("no such glyph")
+				},
+			},
+			want: status.UserFontError,
+		},
+		{
+			name: "render_panic",
+			funcs: Funcs{
+				RenderGlyph: func(*font.ScaledFont, uint64, *context.Context, *font.TextExtents) error {
+					panic("boom")
+				},
+			},
+			want: status.UserFontError,
+		},
+		{
+			name: "init_status",
+			funcs: Funcs{
+				Init: func(*font.ScaledFont, *context.Context, *font.FontExtents) error {
+					return status.NoMemory
+				},
+				RenderGlyph: squareGlyph,
+			},
+			want: status.NoMemory,
+		},
+		{
+			name: "unicode_to_glyph_panic",
+			funcs: Funcs{
+				RenderGlyph: squareGlyph,
+				UnicodeToGlyph: func(*font.ScaledFont, rune) (uint64, error) {
+					panic("boom")
+				},
+			},
+			want: status.UserFontError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := newTestContext(t, 20, 20)
+			ctx.SetFontFace(newTestFace(t, tt.funcs))
+			ctx.MoveTo(0, 15)
+			ctx.ShowText("a")
+			assert.Equal(t, tt.want, ctx.Status())
+		})
+	}
+}
+
+// TestNotImplementedFallsBack tests that returning
+// status.UserFontNotImplemented falls back to Cairo's default mapping.
+func TestNotImplementedFallsBack(t *testing.T) {
+	face := newTestFace(t, Funcs{
+		RenderGlyph: squareGlyph,
+		TextToGlyphs: func(*font.ScaledFont, string) ([]font.Glyph, error) {
+			return nil, status.UserFontNotImplemented
+		},
+	})
+
+	glyphs, err := newTestScaledFont(t, face, 10).TextToGlyphs(0, 0, "ab")
+	require.NoError(t, err)
+	require.Len(t, glyphs, 2)
+	assert.Equal(t, uint64('a'), glyphs[0].Index)
+	assert.Equal(t, uint64('b'), glyphs[1].Index)
+}
+
+// TestTextClusters tests mapping glyphs back to the text they came from.
+func TestTextClusters(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		numGlyphs int
+		want      []font.TextCluster
+	}{
+		{"empty", "", 0, nil},
+		{"one_per_rune", "aé", 2, []font.TextCluster{{NumBytes: 1, NumGlyphs: 1}, {NumBytes: 2, NumGlyphs: 1}}},
+		{"ligature", "ffi", 1, []font.TextCluster{{NumBytes: 3, NumGlyphs: 1}}},
+		{"invalid_utf8", "a\xff", 2, []font.TextCluster{{NumBytes: 1, NumGlyphs: 1}, {NumBytes: 1, NumGlyphs: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, textClusters(tt.text, tt.numGlyphs))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// ABOUTME: Locates the font committed for tests, so font-dependent tests are reproducible on any host.
+// ABOUTME: The font is Share Tech Mono, under the SIL Open Font License (testdata/OFL.txt).
+
+// Package testfont provides the TrueType font used by tests that load real
+// font files, instead of searching the host for an installed font.
+package testfont
+
+import (
+	"bytes"
+	_ "embed"
+	"path/filepath"
+	"runtime"
+)
+
+// fileName is the test font, relative to this package's directory.
+const fileName = "testdata/ShareTechMono-Regular.ttf"
+
+//go:embed testdata/ShareTechMono-Regular.ttf
+var data []byte
+
+// Path returns the absolute path of the test font file.
+func Path() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), filepath.FromSlash(fileName))
+}
+
+// Bytes returns a copy of the test font file's contents, which the caller
+// may modify.
+func Bytes() []byte {
+	return bytes.Clone(data)
+}
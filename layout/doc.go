@@ -0,0 +1,49 @@
+// ABOUTME: Package layout breaks UTF-8 text into measured, aligned lines and draws them with a Context.
+// ABOUTME: Provides word wrapping, alignment, line height, ellipsis truncation and rich text spans.
+
+// Package layout lays out paragraphs of text with a [context.Context]'s
+// current font.
+//
+// Cairo's toy text API draws a single line at the current point. Layout
+// builds on Context.TextExtents and Context.FontExtents to break text into
+// lines that fit a maximum width, align them, and space them by a line
+// height:
+//
+//	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightNormal)
+//	ctx.SetFontSize(14)
+//
+//	l, err := layout.New(ctx, description, layout.Options{
+//	    Width:    240,
+//	    Align:    layout.AlignJustify,
+//	    MaxLines: 3,
+//	    Ellipsis: "…",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	l.Draw(ctx, 20, 20)
+//
+// # Line Breaking
+//
+// Newlines in the text start new paragraphs. Within a paragraph, lines
+// break at whitespace, and runs of whitespace are drawn as a single space.
+// A word wider than the layout is broken between characters.
+//
+// Breaking is greedy and does not hyphenate, shape or reorder text, so it
+// suits labels, cards and reports in left-to-right scripts rather than
+// typesetting.
+//
+// # Rich Text
+//
+// [RichText] draws a single line made of [Span] values, each with its own
+// toy font, size, source pattern, underline, strikethrough and letter
+// spacing. Spans are placed one after another from their measured advances
+// and share a baseline, so mixing fonts does not drift the way separate
+// ShowText calls positioned by hand can.
+//
+// # Measurements
+//
+// A [Layout] holds a box for each line, measured from the layout's origin
+// at the top left corner. Lines are measured with the context's font when
+// the layout is created, so the font must not change between New and Draw.
+package layout
@@ -0,0 +1,324 @@
+// ABOUTME: Implements Layout, which wraps text into lines measured with a Context's current font.
+// ABOUTME: Computes line boxes for each alignment and draws the lines with Context.ShowText.
+
+package layout
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/status"
+)
+
+// Alignment specifies how lines are positioned horizontally within a layout.
+//
+//go:generate stringer -type=Alignment
+type Alignment int
+
+const (
+	// AlignLeft places lines against the left edge of the layout.
+	AlignLeft Alignment = iota
+
+	// AlignCenter centers lines within the layout.
+	AlignCenter
+
+	// AlignRight places lines against the right edge of the layout.
+	AlignRight
+
+	// AlignJustify stretches the spaces between words so that lines fill
+	// the layout width. The last line of each paragraph, and lines with a
+	// single word, are aligned left. Without a Width, it is the same as
+	// AlignLeft.
+	AlignJustify
+)
+
+// Options controls how text is broken into lines and positioned.
+type Options struct {
+	// Width is the maximum width of a line in user-space units. If it is
+	// zero or negative, lines are only broken at newlines, and alignment is
+	// relative to the widest line.
+	Width float64
+
+	// Align positions lines horizontally.
+	Align Alignment
+
+	// LineHeight is the distance between baselines as a multiple of the
+	// font's height. If it is zero or negative, 1 is used.
+	LineHeight float64
+
+	// MaxLines limits the number of lines. If the text needs more, it is
+	// cut off after the last line allowed. Zero or negative means no limit.
+	MaxLines int
+
+	// Ellipsis is appended to the last line when text is cut off by
+	// MaxLines, such as "…" or "...". Characters are removed from the end of
+	// the line so that it still fits Width. If it is empty, the text is cut
+	// off without a marker.
+	Ellipsis string
+}
+
+// Line is a line of laid out text. Positions are relative to the layout's
+// origin at the top left corner.
+type Line struct {
+	// Text is the text of the line.
+	Text string
+
+	// X is the horizontal position at which the line starts.
+	X float64
+
+	// Y is the top of the line's box.
+	Y float64
+
+	// Width is the advance width of the line as drawn. Justified lines are
+	// as wide as the layout.
+	Width float64
+
+	// Height is the height of the line's box, which is the line height.
+	Height float64
+
+	// Baseline is the vertical position of the line's baseline.
+	Baseline float64
+
+	// words holds the words of a justified line, with their positions
+	// relative to X. It is nil for lines drawn as a whole.
+	words []word
+}
+
+type word struct {
+	text string
+	x    float64
+}
+
+// Layout is text broken into lines and measured for drawing.
+type Layout struct {
+	// Lines holds the laid out lines, from top to bottom.
+	Lines []Line
+
+	// Width is the width of the layout: Options.Width if it was set, or the
+	// width of the widest line.
+	Width float64
+
+	// Height is the total height of the lines.
+	Height float64
+
+	// Truncated reports whether text was cut off by Options.MaxLines.
+	Truncated bool
+}
+
+// New lays out text with the current font face, size and transformation of
+// ctx, which is used only for measuring.
+//
+// Returns status.InvalidString if text is not valid UTF-8, or the status of
+// ctx if it is in an error state or has been closed.
+//
+// Example:
+//
+//	l, err := layout.New(ctx, "Quarterly revenue grew 12% on strong subscription sales.", layout.Options{
+//	    Width: 200,
+//	    Align: layout.AlignCenter,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	// Center the block vertically in a 120-unit tall card at (10, 10).
+//	l.Draw(ctx, 10, 10+(120-l.Height)/2)
+func New(ctx *context.Context, text string, opts Options) (*Layout, error) {
+	if st := ctx.Status(); st != status.Success {
+		return nil, st
+	}
+	if !utf8.ValidString(text) {
+		return nil, status.InvalidString
+	}
+
+	b := breaker{
+		measure: func(s string) float64 { return ctx.TextExtents(s).XAdvance },
+		width:   opts.Width,
+	}
+
+	var lines []brokenLine
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines = append(lines, b.wrap(paragraph)...)
+	}
+
+	l := &Layout{}
+	if opts.MaxLines > 0 && len(lines) > opts.MaxLines {
+		lines = lines[:opts.MaxLines]
+		last := &lines[len(lines)-1]
+		last.text = b.ellipsize(last.text, opts.Ellipsis)
+		last.paragraphEnd = true
+		l.Truncated = true
+	}
+
+	fe := ctx.FontExtents()
+	lineHeight := fe.Height
+	if opts.LineHeight > 0 {
+		lineHeight *= opts.LineHeight
+	}
+	// Split the leading evenly above and below the glyphs, so the text is
+	// centered vertically in its line box.
+	baseline := (lineHeight-fe.Ascent-fe.Descent)/2 + fe.Ascent
+
+	l.Lines = make([]Line, len(lines))
+	for i, bl := range lines {
+		y := float64(i) * lineHeight
+		l.Lines[i] = Line{
+			Text:     bl.text,
+			Y:        y,
+			Width:    b.measure(bl.text),
+			Height:   lineHeight,
+			Baseline: y + baseline,
+		}
+		l.Width = max(l.Width, l.Lines[i].Width)
+	}
+	if opts.Width > 0 {
+		l.Width = opts.Width
+	}
+	l.Height = float64(len(lines)) * lineHeight
+
+	for i := range l.Lines {
+		line := &l.Lines[i]
+		switch opts.Align {
+		case AlignCenter:
+			line.X = (l.Width - line.Width) / 2
+		case AlignRight:
+			line.X = l.Width - line.Width
+		case AlignJustify:
+			if opts.Width > 0 && !lines[i].paragraphEnd {
+				b.justify(line, l.Width)
+			}
+		}
+	}
+	return l, nil
+}
+
+// Draw draws the lines with ctx's current source and font, with the layout's
+// top left corner at (x, y). The font should be the one the layout was
+// created with. The current point is left at the end of the last line.
+func (l *Layout) Draw(ctx *context.Context, x, y float64) {
+	for _, line := range l.Lines {
+		if line.words == nil {
+			if line.Text == "" {
+				continue
+			}
+			ctx.MoveTo(x+line.X, y+line.Baseline)
+			ctx.ShowText(line.Text)
+			continue
+		}
+		for _, w := range line.words {
+			ctx.MoveTo(x+line.X+w.x, y+line.Baseline)
+			ctx.ShowText(w.text)
+		}
+	}
+}
+
+// brokenLine is a line produced by breaking a paragraph, before it is
+// positioned.
+type brokenLine struct {
+	text string
+
+	// paragraphEnd reports whether the line ends its paragraph, so it is
+	// not justified.
+	paragraphEnd bool
+}
+
+// breaker breaks paragraphs into lines no wider than width, as measured by
+// measure.
+type breaker struct {
+	measure func(string) float64
+	width   float64
+}
+
+// wrap breaks a paragraph into lines. An empty paragraph is a single empty
+// line.
+func (b *breaker) wrap(paragraph string) []brokenLine {
+	words := strings.Fields(paragraph)
+	if b.width <= 0 || len(words) == 0 {
+		return []brokenLine{{text: strings.Join(words, " "), paragraphEnd: true}}
+	}
+
+	var lines []brokenLine
+	current := ""
+	for _, w := range words {
+		candidate := w
+		if current != "" {
+			candidate = current + " " + w
+		}
+		if b.measure(candidate) <= b.width {
+			current = candidate
+			continue
+		}
+
+		if current != "" {
+			lines = append(lines, brokenLine{text: current})
+		}
+		for b.measure(w) > b.width {
+			n := max(b.fit(w, b.width), len(firstRune(w)))
+			lines = append(lines, brokenLine{text: w[:n]})
+			w = w[n:]
+		}
+		current = w
+	}
+	if current != "" {
+		lines = append(lines, brokenLine{text: current})
+	}
+	lines[len(lines)-1].paragraphEnd = true
+	return lines
+}
+
+// fit returns the length in bytes of the longest prefix of s, ending on a
+// character boundary, that is no wider than width.
+func (b *breaker) fit(s string, width float64) int {
+	n := 0
+	for i, r := range s {
+		end := i + utf8.RuneLen(r)
+		if b.measure(s[:end]) > width {
+			break
+		}
+		n = end
+	}
+	return n
+}
+
+// ellipsize appends ellipsis to a line that was cut off, removing characters
+// from its end as needed to keep it within the width.
+func (b *breaker) ellipsize(line, ellipsis string) string {
+	if ellipsis == "" {
+		return line
+	}
+	if b.width <= 0 || b.measure(line+ellipsis) <= b.width {
+		return line + ellipsis
+	}
+	n := b.fit(line, b.width-b.measure(ellipsis))
+	return strings.TrimRight(line[:n], " ") + ellipsis
+}
+
+// justify spreads the words of line across width.
+func (b *breaker) justify(line *Line, width float64) {
+	words := strings.Fields(line.Text)
+	if len(words) < 2 {
+		return
+	}
+
+	total := 0.0
+	advances := make([]float64, len(words))
+	for i, w := range words {
+		advances[i] = b.measure(w)
+		total += advances[i]
+	}
+	gap := (width - total) / float64(len(words)-1)
+
+	line.words = make([]word, len(words))
+	x := 0.0
+	for i, w := range words {
+		line.words[i] = word{text: w, x: x}
+		x += advances[i] + gap
+	}
+	line.Width = width
+}
+
+// firstRune returns the first character of s.
+func firstRune(s string) string {
+	_, n := utf8.DecodeRuneInString(s)
+	return s[:n]
+}
@@ -0,0 +1,269 @@
+// ABOUTME: Tests for breaking text into lines, aligning them and drawing them.
+// ABOUTME: Uses a user font with fixed-width glyphs so that every measurement is exact.
+
+package layout
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/font/userfont"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContext returns a context whose font draws every character as a
+// 10×8 block with an advance of 10, spaces included but left blank. The
+// font's ascent is 8, its descent 2 and its height 12.
+func newTestContext(t *testing.T) (*context.Context, *surface.ImageSurface) {
+	t.Helper()
+
+	face, err := userfont.NewFace(userfont.Funcs{
+		Init: func(_ *font.ScaledFont, _ *context.Context, extents *font.FontExtents) error {
+			extents.Ascent = 0.8
+			extents.Descent = 0.2
+			extents.Height = 1.2
+			return nil
+		},
+		RenderGlyph: func(_ *font.ScaledFont, glyph uint64, ctx *context.Context, extents *font.TextExtents) error {
+			if glyph != ' ' {
+				ctx.Rectangle(0, -0.8, 1, 0.8)
+				ctx.Fill()
+			}
+			extents.XAdvance = 1
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, 200, 100)
+	require.NoError(t, err)
+	ctx, err := context.NewContext(surf)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ctx.Close()
+		_ = surf.Close()
+		_ = face.Close()
+	})
+
+	ctx.SetFontFace(face)
+	ctx.SetFontSize(10)
+	return ctx, surf
+}
+
+// lineTexts returns the text of each line of l.
+func lineTexts(l *Layout) []string {
+	texts := make([]string, len(l.Lines))
+	for i, line := range l.Lines {
+		texts[i] = line.Text
+	}
+	return texts
+}
+
+// TestNewWrapping tests where lines are broken.
+func TestNewWrapping(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width float64
+		want  []string
+	}{
+		{"fits", "aaa bbb", 100, []string{"aaa bbb"}},
+		{"wraps_at_spaces", "aaa bbb ccc", 75, []string{"aaa bbb", "ccc"}},
+		{"exact_fit", "aaa bbb ccc", 70, []string{"aaa bbb", "ccc"}},
+		{"collapses_whitespace", "  aaa \t  bbb  ", 100, []string{"aaa bbb"}},
+		{"newlines", "aaa\n\nbbb", 100, []string{"aaa", "", "bbb"}},
+		{"long_word", "abcdefghij", 35, []string{"abc", "def", "ghi", "j"}},
+		{"long_word_after_text", "aa abcdef", 40, []string{"aa", "abcd", "ef"}},
+		{"multibyte", "ééé ééé", 50, []string{"ééé", "ééé"}},
+		{"narrower_than_a_character", "ab", 5, []string{"a", "b"}},
+		{"no_width", "aaa bbb ccc\nddd", 0, []string{"aaa bbb ccc", "ddd"}},
+		{"empty", "", 100, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := newTestContext(t)
+			l, err := New(ctx, tt.text, Options{Width: tt.width})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, lineTexts(l))
+			assert.False(t, l.Truncated)
+		})
+	}
+}
+
+// TestNewAlignment tests the horizontal position and width of lines.
+func TestNewAlignment(t *testing.T) {
+	tests := []struct {
+		name   string
+		align  Alignment
+		width  float64
+		wantX  []float64
+		wantW  []float64
+		layout float64
+	}{
+		{"left", AlignLeft, 100, []float64{0, 0}, []float64{80, 20}, 100},
+		{"center", AlignCenter, 100, []float64{10, 40}, []float64{80, 20}, 100},
+		{"right", AlignRight, 100, []float64{20, 80}, []float64{80, 20}, 100},
+		{"justify", AlignJustify, 100, []float64{0, 0}, []float64{100, 20}, 100},
+		{"center_without_width", AlignCenter, 0, []float64{0, 45}, []float64{110, 20}, 110},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := newTestContext(t)
+			text := "aa bb cc dd"
+			if tt.width == 0 {
+				text = "aa bb cc dd\nee"
+			}
+			l, err := New(ctx, text, Options{Width: tt.width, Align: tt.align})
+			require.NoError(t, err)
+			require.Len(t, l.Lines, 2)
+
+			assert.InDelta(t, tt.layout, l.Width, 1e-9)
+			for i, line := range l.Lines {
+				assert.InDelta(t, tt.wantX[i], line.X, 1e-9, "line %d", i)
+				assert.InDelta(t, tt.wantW[i], line.Width, 1e-9, "line %d", i)
+			}
+		})
+	}
+}
+
+// TestNewJustifySpacing tests that justified words are spread evenly and the
+// last line of each paragraph is left alone.
+func TestNewJustifySpacing(t *testing.T) {
+	ctx, _ := newTestContext(t)
+	l, err := New(ctx, "aa bb cc dd\naa bb", Options{Width: 100, Align: AlignJustify})
+	require.NoError(t, err)
+	require.Equal(t, []string{"aa bb cc", "dd", "aa bb"}, lineTexts(l))
+
+	assert.Equal(t, []word{{"aa", 0}, {"bb", 40}, {"cc", 80}}, l.Lines[0].words)
+	assert.Nil(t, l.Lines[1].words)
+	assert.Nil(t, l.Lines[2].words)
+	assert.InDelta(t, 50.0, l.Lines[2].Width, 1e-9)
+}
+
+// TestNewLineHeight tests line boxes and baselines.
+func TestNewLineHeight(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	l, err := New(ctx, "a\nb", Options{})
+	require.NoError(t, err)
+	require.Len(t, l.Lines, 2)
+	assert.InDelta(t, 24.0, l.Height, 1e-9)
+	assert.InDelta(t, 12.0, l.Lines[1].Y, 1e-9)
+	assert.InDelta(t, 12.0, l.Lines[1].Height, 1e-9)
+	// The 2 units of leading are split above and below the 8 + 2 unit glyphs.
+	assert.InDelta(t, 9.0, l.Lines[0].Baseline, 1e-9)
+	assert.InDelta(t, 21.0, l.Lines[1].Baseline, 1e-9)
+
+	l, err = New(ctx, "a\nb", Options{LineHeight: 1.5})
+	require.NoError(t, err)
+	assert.InDelta(t, 36.0, l.Height, 1e-9)
+	assert.InDelta(t, 18.0, l.Lines[1].Y, 1e-9)
+	assert.InDelta(t, 12.0, l.Lines[0].Baseline, 1e-9)
+	assert.InDelta(t, 30.0, l.Lines[1].Baseline, 1e-9)
+}
+
+// TestNewMaxLines tests cutting off text with and without an ellipsis.
+func TestNewMaxLines(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      Options
+		want      []string
+		truncated bool
+	}{
+		{"fits", Options{Width: 75, MaxLines: 2}, []string{"aaa bbb", "ccc"}, false},
+		{"cut", Options{Width: 75, MaxLines: 1}, []string{"aaa bbb"}, true},
+		{"ellipsis_trims", Options{Width: 75, MaxLines: 1, Ellipsis: "…"}, []string{"aaa bb…"}, true},
+		{"ellipsis_fits", Options{Width: 80, MaxLines: 1, Ellipsis: "…"}, []string{"aaa bbb…"}, true},
+		{"ellipsis_drops_space", Options{Width: 75, MaxLines: 1, Ellipsis: "..."}, []string{"aaa..."}, true},
+		{"ellipsis_without_width", Options{MaxLines: 1, Ellipsis: "…"}, []string{"aaa bbb…"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := newTestContext(t)
+			text := "aaa bbb ccc"
+			if tt.opts.Width == 0 {
+				text = "aaa bbb\nccc"
+			}
+			l, err := New(ctx, text, tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, lineTexts(l))
+			assert.Equal(t, tt.truncated, l.Truncated)
+		})
+	}
+}
+
+// TestNewErrors tests that invalid text and unusable contexts are rejected.
+func TestNewErrors(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	_, err := New(ctx, "a\xffb", Options{})
+	assert.Equal(t, status.InvalidString, err)
+	assert.Equal(t, status.Success, ctx.Status(), "the context should not be put in an error state")
+
+	require.NoError(t, ctx.Close())
+	_, err = New(ctx, "text", Options{})
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestLayoutDraw tests that lines are drawn at their measured positions.
+func TestLayoutDraw(t *testing.T) {
+	ctx, surf := newTestContext(t)
+	ctx.SetSourceRGB(0, 0, 0)
+
+	l, err := New(ctx, "aa bb cc dd", Options{Width: 100, Align: AlignRight})
+	require.NoError(t, err)
+	require.Equal(t, []string{"aa bb cc", "dd"}, lineTexts(l))
+	l.Draw(ctx, 50, 10)
+	require.Equal(t, status.Success, ctx.Status())
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	alpha := func(x, y int) uint32 {
+		_, _, _, a := img.At(x, y).RGBA()
+		return a
+	}
+
+	// The first line spans x = 70..150 with its glyph blocks between
+	// y = 11 and 19; the space between "aa" and "bb" is blank.
+	assert.NotZero(t, alpha(75, 15), "inside the first line")
+	assert.Zero(t, alpha(95, 15), "the space after the first word")
+	assert.NotZero(t, alpha(145, 15), "the end of the first line")
+	assert.Zero(t, alpha(65, 15), "left of the right-aligned first line")
+
+	// The second line spans x = 130..150 with its blocks between y = 23 and
+	// 31.
+	assert.NotZero(t, alpha(135, 27), "inside the second line")
+	assert.Zero(t, alpha(125, 27), "left of the second line")
+}
+
+// TestLayoutDrawJustified tests that justified words are drawn spread out.
+func TestLayoutDrawJustified(t *testing.T) {
+	ctx, surf := newTestContext(t)
+	ctx.SetSourceRGB(0, 0, 0)
+
+	l, err := New(ctx, "aa bb cc dd", Options{Width: 100, Align: AlignJustify})
+	require.NoError(t, err)
+	l.Draw(ctx, 0, 0)
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	alpha := func(x, y int) uint32 {
+		_, _, _, a := img.At(x, y).RGBA()
+		return a
+	}
+
+	// Words start at 0, 40 and 80 instead of 0, 30 and 60.
+	assert.NotZero(t, alpha(45, 5))
+	assert.Zero(t, alpha(35, 5))
+	assert.NotZero(t, alpha(95, 5))
+	assert.Zero(t, alpha(65, 5))
+}
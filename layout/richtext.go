@@ -0,0 +1,258 @@
+// ABOUTME: Implements RichText, a line of text made of spans with their own font, size, source and decorations.
+// ABOUTME: Measures and draws spans one after another on a shared baseline using glyphs and text clusters.
+
+package layout
+
+import (
+	"unicode/utf8"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+)
+
+// Decoration metrics as fractions of the font size. The toy font API does
+// not expose a font's own underline and strikethrough metrics, so typical
+// values are used.
+const (
+	underlineOffset     = 0.1
+	strikethroughOffset = 0.3
+	decorationThickness = 0.05
+)
+
+// Span is a run of text drawn with a single style. Style fields left at
+// their zero values use the Context's current settings.
+type Span struct {
+	// Text is the UTF-8 text of the span.
+	Text string
+
+	// Family selects a font with the toy font API, together with Slant and
+	// Weight. If it is empty, the Context's current font face is used and
+	// Slant and Weight are ignored.
+	Family string
+
+	// Slant is the slant of the font selected by Family.
+	Slant font.Slant
+
+	// Weight is the weight of the font selected by Family.
+	Weight font.Weight
+
+	// Size is the font size. If it is zero or negative, the Context's
+	// current font size is used.
+	Size float64
+
+	// Source is the pattern the span is drawn with. If it is nil, the
+	// Context's current source is used.
+	Source pattern.Pattern
+
+	// Underline draws a line below the baseline under the span.
+	Underline bool
+
+	// Strikethrough draws a line through the span.
+	Strikethrough bool
+
+	// LetterSpacing is extra space added after each character, in
+	// user-space units. It may be negative to tighten text.
+	LetterSpacing float64
+}
+
+// RichText is a single line of text made of spans with different fonts,
+// sizes, sources and decorations. The spans are drawn one after another and
+// share a baseline.
+//
+// Example:
+//
+//	red, _ := pattern.NewSolidPatternRGB(0.8, 0, 0)
+//	defer red.Close()
+//
+//	rt := layout.RichText{Spans: []layout.Span{
+//	    {Text: "Total: ", Family: "sans-serif", Size: 14},
+//	    {Text: "$1,204.00", Family: "sans-serif", Weight: font.WeightBold, Size: 18, Source: red},
+//	    {Text: " overdue", Family: "serif", Slant: font.SlantItalic, Size: 14, Underline: true},
+//	}}
+//	ext, err := rt.Measure(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	err = rt.Draw(ctx, (width-ext.Width)/2, 40)
+type RichText struct {
+	Spans []Span
+}
+
+// RichTextExtents holds the measurements of a RichText. Vertical metrics are
+// the largest of any span's font.
+type RichTextExtents struct {
+	// Width is the total advance width of the spans.
+	Width float64
+
+	// Ascent is the distance from the baseline to the top of the tallest
+	// font.
+	Ascent float64
+
+	// Descent is the distance from the baseline to the bottom of the
+	// deepest font.
+	Descent float64
+
+	// Height is the largest recommended line height of the spans' fonts.
+	Height float64
+}
+
+// Measure returns the extents of the text as Draw would draw it with ctx.
+//
+// Returns status.InvalidString if a span is not valid UTF-8, or the status
+// of ctx if it is in an error state or has been closed.
+func (rt *RichText) Measure(ctx *context.Context) (RichTextExtents, error) {
+	var ext RichTextExtents
+	err := rt.each(ctx, 0, 0, func(_ *Span, run *spanRun) error {
+		ext.Width += run.advance
+		ext.Ascent = max(ext.Ascent, run.fontExtents.Ascent)
+		ext.Descent = max(ext.Descent, run.fontExtents.Descent)
+		ext.Height = max(ext.Height, run.fontExtents.Height)
+		return nil
+	})
+	if err != nil {
+		return RichTextExtents{}, err
+	}
+	return ext, nil
+}
+
+// Draw draws the spans starting at x with their baseline at y. The text is
+// drawn with Context.ShowTextGlyphs, so it stays searchable in PDF output.
+//
+// The Context's font, size and source are restored afterwards. Drawing
+// underlines and strikethroughs clears the current path.
+//
+// Returns status.InvalidString if a span is not valid UTF-8, or the status
+// of ctx if it is in an error state, has been closed or fails while drawing.
+func (rt *RichText) Draw(ctx *context.Context, x, y float64) error {
+	err := rt.each(ctx, x, y, func(s *Span, run *spanRun) error {
+		if s.Source != nil {
+			ctx.SetSource(s.Source)
+		}
+		if err := ctx.ShowTextGlyphs(s.Text, run.glyphs, run.clusters, run.flags); err != nil {
+			return err
+		}
+
+		thickness := decorationThickness * run.size
+		if s.Underline {
+			ctx.NewPath()
+			ctx.Rectangle(run.x, y+underlineOffset*run.size-thickness/2, run.advance, thickness)
+			ctx.Fill()
+		}
+		if s.Strikethrough {
+			ctx.NewPath()
+			ctx.Rectangle(run.x, y-strikethroughOffset*run.size-thickness/2, run.advance, thickness)
+			ctx.Fill()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if st := ctx.Status(); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+// spanRun is a span converted to glyphs at its position in the line.
+type spanRun struct {
+	x           float64
+	glyphs      []font.Glyph
+	clusters    []font.TextCluster
+	flags       font.TextClusterFlags
+	advance     float64
+	size        float64
+	fontExtents font.FontExtents
+}
+
+// each converts every non-empty span to glyphs, placing the first at
+// (x, y), and calls fn with the span's style applied to ctx. The Context's
+// state is restored after each span.
+func (rt *RichText) each(ctx *context.Context, x, y float64, fn func(*Span, *spanRun) error) error {
+	if st := ctx.Status(); st != status.Success {
+		return st
+	}
+	for i := range rt.Spans {
+		if !utf8.ValidString(rt.Spans[i].Text) {
+			return status.InvalidString
+		}
+	}
+
+	for i := range rt.Spans {
+		s := &rt.Spans[i]
+		if s.Text == "" {
+			continue
+		}
+
+		run, err := s.run(ctx, x, y, fn)
+		if err != nil {
+			return err
+		}
+		x += run.advance
+	}
+	return nil
+}
+
+// run applies the span's font to ctx, converts it to glyphs and calls fn.
+func (s *Span) run(ctx *context.Context, x, y float64, fn func(*Span, *spanRun) error) (*spanRun, error) {
+	ctx.Save()
+	defer ctx.Restore()
+
+	if s.Family != "" {
+		ctx.SelectFontFace(s.Family, s.Slant, s.Weight)
+	}
+	if s.Size > 0 {
+		ctx.SetFontSize(s.Size)
+	}
+
+	sf, err := ctx.GetScaledFont()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = sf.Close()
+	}()
+
+	run := &spanRun{x: x, fontExtents: sf.Extents()}
+	run.glyphs, run.clusters, run.flags, err = sf.TextToGlyphsWithClusters(x, y, s.Text)
+	if err != nil {
+		return nil, err
+	}
+	run.advance = sf.TextExtents(s.Text).XAdvance
+	if s.LetterSpacing != 0 {
+		spaceClusters(run.glyphs, run.clusters, run.flags, s.LetterSpacing)
+		run.advance += s.LetterSpacing * float64(len(run.clusters))
+	}
+
+	fm, err := sf.GetFontMatrix()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fm.Close()
+	}()
+	_, run.size = fm.TransformDistance(0, 1)
+
+	if err := fn(s, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// spaceClusters moves the glyphs of each cluster right by spacing times the
+// number of clusters before it in the text.
+func spaceClusters(glyphs []font.Glyph, clusters []font.TextCluster, flags font.TextClusterFlags, spacing float64) {
+	g := 0
+	for i, c := range clusters {
+		for k := 0; k < c.NumGlyphs; k++ {
+			j := g + k
+			if flags&font.TextClusterFlagBackward != 0 {
+				j = len(glyphs) - 1 - j
+			}
+			glyphs[j].X += float64(i) * spacing
+		}
+		g += c.NumGlyphs
+	}
+}
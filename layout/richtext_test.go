@@ -0,0 +1,170 @@
+// ABOUTME: Tests for RichText: span measurement, shared baselines, sources, decorations and letter spacing.
+// ABOUTME: Uses the fixed-width user font from the layout tests, and toy fonts to check font switching.
+
+package layout
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/pattern"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRichTextMeasure tests that spans are measured with their own sizes.
+func TestRichTextMeasure(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	rt := RichText{Spans: []Span{
+		{Text: "ab", Size: 10},
+		{Text: "", Size: 50},
+		{Text: "cd", Size: 20},
+		{Text: "e"},
+	}}
+	ext, err := rt.Measure(ctx)
+	require.NoError(t, err)
+	// The last span uses the context's size of 10.
+	assert.InDelta(t, 70.0, ext.Width, 1e-9)
+	assert.InDelta(t, 16.0, ext.Ascent, 1e-9)
+	assert.InDelta(t, 4.0, ext.Descent, 1e-9)
+	assert.InDelta(t, 24.0, ext.Height, 1e-9)
+
+	empty, err := (&RichText{}).Measure(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RichTextExtents{}, empty)
+}
+
+// TestRichTextLetterSpacing tests that spacing is added after each character.
+func TestRichTextLetterSpacing(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	rt := RichText{Spans: []Span{{Text: "aéc", Size: 10, LetterSpacing: 2}}}
+	ext, err := rt.Measure(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 36.0, ext.Width, 1e-9)
+
+	glyphs := []font.Glyph{{X: 0}, {X: 10}, {X: 20}}
+	clusters := []font.TextCluster{{NumBytes: 1, NumGlyphs: 1}, {NumBytes: 2, NumGlyphs: 1}, {NumBytes: 1, NumGlyphs: 1}}
+	spaceClusters(glyphs, clusters, font.TextClusterFlagsNone, 2)
+	assert.Equal(t, []font.Glyph{{X: 0}, {X: 12}, {X: 24}}, glyphs)
+
+	glyphs = []font.Glyph{{X: 0}, {X: 10}}
+	clusters = []font.TextCluster{{NumBytes: 1, NumGlyphs: 1}, {NumBytes: 1, NumGlyphs: 1}}
+	spaceClusters(glyphs, clusters, font.TextClusterFlagBackward, 2)
+	assert.Equal(t, []font.Glyph{{X: 2}, {X: 10}}, glyphs)
+}
+
+// TestRichTextToyFonts tests that spans selecting toy fonts are measured as
+// ShowText would advance with the same fonts.
+func TestRichTextToyFonts(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	ctx.SelectFontFace("serif", font.SlantNormal, font.WeightNormal)
+	ctx.SetFontSize(12)
+	want := ctx.TextExtents("Total ").XAdvance
+	ctx.SelectFontFace("sans-serif", font.SlantNormal, font.WeightBold)
+	ctx.SetFontSize(18)
+	want += ctx.TextExtents("$42").XAdvance
+	ctx.SelectFontFace("serif", font.SlantItalic, font.WeightNormal)
+	ctx.SetFontSize(12)
+	want += ctx.TextExtents(" due").XAdvance
+
+	rt := RichText{Spans: []Span{
+		{Text: "Total ", Family: "serif", Size: 12},
+		{Text: "$42", Family: "sans-serif", Weight: font.WeightBold, Size: 18},
+		{Text: " due", Family: "serif", Slant: font.SlantItalic, Size: 12},
+	}}
+	ext, err := rt.Measure(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, want, ext.Width, 1e-9)
+}
+
+// TestRichTextDraw tests that spans share a baseline, use their own sources
+// and leave the context's state unchanged.
+func TestRichTextDraw(t *testing.T) {
+	ctx, surf := newTestContext(t)
+	ctx.SetSourceRGB(0, 0, 1)
+
+	red, err := pattern.NewSolidPatternRGB(1, 0, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = red.Close()
+	}()
+
+	before := ctx.FontExtents()
+	rt := RichText{Spans: []Span{
+		{Text: "a", Size: 10, Source: red},
+		{Text: "b", Size: 40},
+	}}
+	require.NoError(t, rt.Draw(ctx, 10, 50))
+	assert.Equal(t, before, ctx.FontExtents(), "the font should be restored")
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+
+	// "a" is a 10×8 block at x = 10..20 and "b" a 40×32 block at
+	// x = 20..60, both resting on the baseline at y = 50.
+	r, g, b, a := img.At(15, 47).RGBA()
+	assert.Equal(t, [4]uint32{0xffff, 0, 0, 0xffff}, [4]uint32{r, g, b, a}, "the first span is red")
+	r, g, b, a = img.At(40, 47).RGBA()
+	assert.Equal(t, [4]uint32{0, 0, 0xffff, 0xffff}, [4]uint32{r, g, b, a}, "the second span uses the context's source")
+	_, _, _, a = img.At(40, 20).RGBA()
+	assert.NotZero(t, a, "the larger span rises above the smaller one")
+	_, _, _, a = img.At(15, 52).RGBA()
+	assert.Zero(t, a, "nothing is drawn below the baseline")
+
+	src, err := ctx.GetSource()
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+	assert.NotEqual(t, red.Ptr(), src.Ptr(), "the source should be restored")
+}
+
+// TestRichTextDecorations tests underlines and strikethroughs.
+func TestRichTextDecorations(t *testing.T) {
+	ctx, surf := newTestContext(t)
+	ctx.SetSourceRGB(0, 0, 0)
+
+	// At size 40 the decorations are 2 units thick; the underline is
+	// centered 4 units below the baseline and the strikethrough 12 above.
+	rt := RichText{Spans: []Span{
+		{Text: "a a", Size: 40, Underline: true},
+		{Text: "a a", Size: 40, Strikethrough: true},
+	}}
+	require.NoError(t, rt.Draw(ctx, 0, 50))
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	alpha := func(x, y int) uint32 {
+		_, _, _, a := img.At(x, y).RGBA()
+		return a
+	}
+
+	assert.NotZero(t, alpha(60, 54), "underline under the first span's space")
+	assert.Zero(t, alpha(180, 54), "no underline under the second span")
+	assert.NotZero(t, alpha(180, 38), "strikethrough through the second span's space")
+	assert.Zero(t, alpha(60, 38), "no strikethrough through the first span")
+}
+
+// TestRichTextErrors tests that invalid text and unusable contexts are
+// rejected.
+func TestRichTextErrors(t *testing.T) {
+	ctx, _ := newTestContext(t)
+
+	rt := RichText{Spans: []Span{{Text: "ok"}, {Text: "\xff"}}}
+	_, err := rt.Measure(ctx)
+	assert.Equal(t, status.InvalidString, err)
+	assert.Equal(t, status.InvalidString, rt.Draw(ctx, 0, 0))
+	assert.Equal(t, status.Success, ctx.Status())
+
+	require.NoError(t, ctx.Close())
+	rt = RichText{Spans: []Span{{Text: "ok"}}}
+	_, err = rt.Measure(ctx)
+	assert.Equal(t, status.NullPointer, err)
+	assert.Equal(t, status.NullPointer, rt.Draw(ctx, 0, 0))
+}
@@ -0,0 +1,44 @@
+// ABOUTME: Package pangocairo lays out and draws text with Pango on a Cairo context.
+// ABOUTME: It is opt-in: build with -tags pango to compile it against the pangocairo library.
+
+// Package pangocairo binds Pango's text layout engine to a
+// [context.Context], for text that needs more than the toy font API and the
+// layout package provide: markup with mixed styles inside a paragraph,
+// fontconfig font descriptions, complex scripts, bidirectional text and
+// hyphenation.
+//
+// A [Layout] is created for a Context and holds a block of text, a font
+// description and paragraph settings. Pango wraps the text to the layout's
+// width, one paragraph per line break, and [Layout.Show] draws it with its
+// top-left corner at the Context's current point:
+//
+//	l, err := pangocairo.NewLayout(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	defer l.Close()
+//
+//	l.SetFontDescription("Serif 11")
+//	l.SetWidth(400)
+//	l.SetAlignment(pangocairo.AlignLeft)
+//	l.SetJustify(true)
+//	if err := l.SetMarkup("<b>Summary</b>\nRevenue grew <i>12%</i> over the quarter."); err != nil {
+//	    return err
+//	}
+//
+//	ctx.MoveTo(40, 60)
+//	if err := l.Show(); err != nil {
+//	    return err
+//	}
+//	_, height := l.GetSize()
+//
+// Distances are in the Context's user-space units; the conversion from
+// Pango units is done for you.
+//
+// # Build Tags
+//
+// The package requires Pango (pangocairo pkg-config entry). Pango is not a
+// dependency of Cairo, so the package is only compiled with -tags pango:
+//
+//	go build -tags pango ./...
+package pangocairo
@@ -0,0 +1,364 @@
+// ABOUTME: Defines Layout, a PangoLayout bound to a Context, with text, markup, font and paragraph settings.
+// ABOUTME: Converts between Pango units and user space and draws through pango_cairo_show_layout.
+
+//go:build pango
+
+package pangocairo
+
+import (
+	"runtime"
+	"sync"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/status"
+)
+
+// Alignment specifies how the lines of a Layout are aligned within its
+// width.
+//
+//go:generate stringer -type=Alignment
+type Alignment int
+
+// The iota values below must match Pango's PangoAlignment C enum exactly.
+const (
+	// AlignLeft aligns lines to the left edge of the layout.
+	AlignLeft Alignment = iota
+
+	// AlignCenter centers lines within the layout.
+	AlignCenter
+
+	// AlignRight aligns lines to the right edge of the layout.
+	AlignRight
+)
+
+// WrapMode specifies where lines of a Layout may be broken.
+//
+//go:generate stringer -type=WrapMode
+type WrapMode int
+
+// The iota values below must match Pango's PangoWrapMode C enum exactly.
+const (
+	// WrapWord breaks lines between words.
+	WrapWord WrapMode = iota
+
+	// WrapChar breaks lines between characters.
+	WrapChar
+
+	// WrapWordChar breaks lines between words, or between characters if a
+	// word does not fit on a line by itself.
+	WrapWordChar
+)
+
+// Rectangle is a rectangle in user-space units.
+type Rectangle struct {
+	X, Y, Width, Height float64
+}
+
+// Layout is a paragraph of text laid out by Pango for a Context.
+//
+// The layout takes its font options and transformation from the Context it
+// was created for. Show updates them before drawing, so the Context may be
+// transformed between creating the layout and drawing it.
+//
+// Layout is safe for concurrent use. Methods on a closed Layout have no
+// effect and getters return zero values.
+type Layout struct {
+	sync.RWMutex
+	ptr LayoutPtr
+	ctx *context.Context
+}
+
+// NewLayout creates an empty layout for ctx.
+//
+// Returns the status of ctx if it is in an error state or has been closed.
+func NewLayout(ctx *context.Context) (*Layout, error) {
+	if st := ctx.Status(); st != status.Success {
+		return nil, st
+	}
+
+	l := &Layout{ctx: ctx}
+	err := ctx.WithPtr(func(cr unsafe.Pointer) {
+		l.ptr = layoutCreate(cr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if l.ptr == nil {
+		return nil, status.NoMemory
+	}
+	runtime.SetFinalizer(l, (*Layout).close)
+	return l, nil
+}
+
+// Ptr returns the underlying C PangoLayout pointer.
+func (l *Layout) Ptr() unsafe.Pointer {
+	l.RLock()
+	defer l.RUnlock()
+
+	return unsafe.Pointer(l.ptr) //nolint:gosec
+}
+
+// Close releases the layout. It does not close the Context.
+func (l *Layout) Close() error {
+	return l.close()
+}
+
+// SetText sets the text of the layout, with the layout's font and no
+// markup. Each line break starts a new paragraph.
+//
+// Returns status.InvalidString if text is not valid UTF-8, or
+// status.NullPointer if the layout has been closed.
+func (l *Layout) SetText(text string) error {
+	if !utf8.ValidString(text) {
+		return status.InvalidString
+	}
+	return l.withLockErr(func() error {
+		layoutSetText(l.ptr, text)
+		return nil
+	})
+}
+
+// SetMarkup sets the text of the layout from Pango markup, such as
+// "<b>bold</b> and <span foreground=\"red\">red</span>". Characters that are
+// special in markup must be escaped, as in "&amp;" and "&lt;".
+//
+// Returns status.InvalidString if markup is not valid UTF-8 or cannot be
+// parsed, in which case the layout is unchanged, or status.NullPointer if
+// the layout has been closed.
+func (l *Layout) SetMarkup(markup string) error {
+	if !utf8.ValidString(markup) || !validMarkup(markup) {
+		return status.InvalidString
+	}
+	return l.withLockErr(func() error {
+		layoutSetMarkup(l.ptr, markup)
+		return nil
+	})
+}
+
+// GetText returns the text of the layout, without markup.
+func (l *Layout) GetText() string {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return ""
+	}
+	return layoutGetText(l.ptr)
+}
+
+// SetFontDescription sets the font of the layout from a Pango font
+// description such as "Sans 12", "Serif Bold Italic 10" or
+// "DejaVu Sans Mono, monospace 9". Fields left out use Pango's defaults.
+// An empty description resets the layout to the default font.
+func (l *Layout) SetFontDescription(desc string) {
+	l.withLock(func() {
+		layoutSetFontDescription(l.ptr, desc)
+	})
+}
+
+// GetFontDescription returns the font description set on the layout in
+// normalized form, or an empty string if none is set.
+func (l *Layout) GetFontDescription() string {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return ""
+	}
+	return layoutGetFontDescription(l.ptr)
+}
+
+// SetWidth sets the width that lines are wrapped to, in user-space units. A
+// negative width turns wrapping off, so each paragraph is a single line.
+func (l *Layout) SetWidth(width float64) {
+	l.withLock(func() {
+		layoutSetWidth(l.ptr, width)
+	})
+}
+
+// GetWidth returns the width that lines are wrapped to, or -1 if wrapping
+// is off.
+func (l *Layout) GetWidth() float64 {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return -1
+	}
+	return layoutGetWidth(l.ptr)
+}
+
+// SetWrap sets where lines may be broken when the layout has a width.
+func (l *Layout) SetWrap(wrap WrapMode) {
+	l.withLock(func() {
+		layoutSetWrap(l.ptr, wrap)
+	})
+}
+
+// GetWrap returns where lines may be broken.
+func (l *Layout) GetWrap() WrapMode {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return WrapWord
+	}
+	return layoutGetWrap(l.ptr)
+}
+
+// SetAlignment sets how lines are aligned within the layout's width.
+func (l *Layout) SetAlignment(align Alignment) {
+	l.withLock(func() {
+		layoutSetAlignment(l.ptr, align)
+	})
+}
+
+// GetAlignment returns how lines are aligned.
+func (l *Layout) GetAlignment() Alignment {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return AlignLeft
+	}
+	return layoutGetAlignment(l.ptr)
+}
+
+// SetJustify sets whether wrapped lines are stretched to fill the layout's
+// width. The last line of each paragraph is aligned by the alignment
+// instead.
+func (l *Layout) SetJustify(justify bool) {
+	l.withLock(func() {
+		layoutSetJustify(l.ptr, justify)
+	})
+}
+
+// GetJustify returns whether wrapped lines are justified.
+func (l *Layout) GetJustify() bool {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return false
+	}
+	return layoutGetJustify(l.ptr)
+}
+
+// SetSpacing sets the extra space between lines, in user-space units.
+func (l *Layout) SetSpacing(spacing float64) {
+	l.withLock(func() {
+		layoutSetSpacing(l.ptr, spacing)
+	})
+}
+
+// GetSpacing returns the extra space between lines.
+func (l *Layout) GetSpacing() float64 {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return 0
+	}
+	return layoutGetSpacing(l.ptr)
+}
+
+// GetExtents returns the ink and logical extents of the layout, relative to
+// its top-left corner. The ink extents cover the pixels the glyphs draw;
+// the logical extents cover the lines' full height and advances, and are
+// the ones to use for placing text.
+func (l *Layout) GetExtents() (ink, logical Rectangle) {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return Rectangle{}, Rectangle{}
+	}
+	return layoutGetExtents(l.ptr)
+}
+
+// GetSize returns the width and height of the layout's logical extents.
+func (l *Layout) GetSize() (width, height float64) {
+	_, logical := l.GetExtents()
+	return logical.Width, logical.Height
+}
+
+// GetLineCount returns the number of lines in the layout after wrapping.
+func (l *Layout) GetLineCount() int {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.ptr == nil {
+		return 0
+	}
+	return layoutGetLineCount(l.ptr)
+}
+
+// Update updates the layout for the current font options and transformation
+// of its Context. Extents measured before a change to the Context's
+// transformation may differ afterwards, because hinting depends on it.
+//
+// Returns status.NullPointer if the layout or its Context has been closed.
+func (l *Layout) Update() error {
+	return l.withLockErr(func() error {
+		return l.ctx.WithPtr(func(cr unsafe.Pointer) {
+			layoutUpdate(cr, l.ptr)
+		})
+	})
+}
+
+// Show updates the layout for its Context, as Update does, and draws it
+// with its top-left corner at the Context's current point, using the
+// Context's source for text without a color of its own.
+//
+// Returns status.NullPointer if the layout or its Context has been closed,
+// or the status of the Context if drawing fails.
+func (l *Layout) Show() error {
+	err := l.withLockErr(func() error {
+		return l.ctx.WithPtr(func(cr unsafe.Pointer) {
+			layoutUpdate(cr, l.ptr)
+			layoutShow(cr, l.ptr)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if st := l.ctx.Status(); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+func (l *Layout) withLock(fn func()) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.ptr == nil {
+		return
+	}
+	fn()
+}
+
+func (l *Layout) withLockErr(fn func() error) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.ptr == nil {
+		return status.NullPointer
+	}
+	return fn()
+}
+
+func (l *Layout) close() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.ptr != nil {
+		layoutClose(l.ptr)
+		runtime.SetFinalizer(l, nil)
+		l.ptr = nil
+	}
+
+	return nil
+}
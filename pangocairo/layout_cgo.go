@@ -0,0 +1,178 @@
+// ABOUTME: CGO bindings for PangoLayout and the pango_cairo functions that bind it to a cairo_t.
+// ABOUTME: Converts Pango units to and from user-space doubles at the boundary.
+
+//go:build pango
+
+package pangocairo
+
+// #cgo pkg-config: pangocairo
+// #include <pango/pangocairo.h>
+// #include <stdlib.h>
+//
+// static int _validMarkup(const char *markup) {
+//     GError *err = NULL;
+//     if (!pango_parse_markup(markup, -1, 0, NULL, NULL, NULL, &err)) {
+//         g_clear_error(&err);
+//         return 0;
+//     }
+//     return 1;
+// }
+//
+// static void _setFontDescription(PangoLayout *layout, const char *desc) {
+//     if (desc == NULL) {
+//         pango_layout_set_font_description(layout, NULL);
+//         return;
+//     }
+//     PangoFontDescription *fd = pango_font_description_from_string(desc);
+//     pango_layout_set_font_description(layout, fd);
+//     pango_font_description_free(fd);
+// }
+//
+// static char *_getFontDescription(PangoLayout *layout) {
+//     const PangoFontDescription *fd = pango_layout_get_font_description(layout);
+//     return fd == NULL ? NULL : pango_font_description_to_string(fd);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+type LayoutPtr *C.PangoLayout
+
+func layoutCreate(cr unsafe.Pointer) LayoutPtr {
+	return LayoutPtr(C.pango_cairo_create_layout((*C.cairo_t)(cr)))
+}
+
+func layoutClose(ptr LayoutPtr) {
+	C.g_object_unref(C.gpointer(ptr))
+}
+
+func validMarkup(markup string) bool {
+	cMarkup := C.CString(markup)
+	defer C.free(unsafe.Pointer(cMarkup))
+
+	return C._validMarkup(cMarkup) != 0
+}
+
+func layoutSetText(ptr LayoutPtr, text string) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	C.pango_layout_set_text(ptr, cText, C.int(len(text)))
+}
+
+func layoutSetMarkup(ptr LayoutPtr, markup string) {
+	cMarkup := C.CString(markup)
+	defer C.free(unsafe.Pointer(cMarkup))
+
+	C.pango_layout_set_markup(ptr, cMarkup, C.int(len(markup)))
+}
+
+func layoutGetText(ptr LayoutPtr) string {
+	return C.GoString(C.pango_layout_get_text(ptr))
+}
+
+func layoutSetFontDescription(ptr LayoutPtr, desc string) {
+	if desc == "" {
+		C._setFontDescription(ptr, nil)
+		return
+	}
+	cDesc := C.CString(desc)
+	defer C.free(unsafe.Pointer(cDesc))
+
+	C._setFontDescription(ptr, cDesc)
+}
+
+func layoutGetFontDescription(ptr LayoutPtr) string {
+	cDesc := C._getFontDescription(ptr)
+	if cDesc == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(cDesc))
+
+	return C.GoString(cDesc)
+}
+
+func layoutSetWidth(ptr LayoutPtr, width float64) {
+	if width < 0 {
+		C.pango_layout_set_width(ptr, -1)
+		return
+	}
+	C.pango_layout_set_width(ptr, C.pango_units_from_double(C.double(width)))
+}
+
+func layoutGetWidth(ptr LayoutPtr) float64 {
+	width := C.pango_layout_get_width(ptr)
+	if width < 0 {
+		return -1
+	}
+	return fromUnits(width)
+}
+
+func layoutSetWrap(ptr LayoutPtr, wrap WrapMode) {
+	C.pango_layout_set_wrap(ptr, C.PangoWrapMode(wrap))
+}
+
+func layoutGetWrap(ptr LayoutPtr) WrapMode {
+	return WrapMode(C.pango_layout_get_wrap(ptr))
+}
+
+func layoutSetAlignment(ptr LayoutPtr, align Alignment) {
+	C.pango_layout_set_alignment(ptr, C.PangoAlignment(align))
+}
+
+func layoutGetAlignment(ptr LayoutPtr) Alignment {
+	return Alignment(C.pango_layout_get_alignment(ptr))
+}
+
+func layoutSetJustify(ptr LayoutPtr, justify bool) {
+	var cJustify C.gboolean
+	if justify {
+		cJustify = 1
+	}
+	C.pango_layout_set_justify(ptr, cJustify)
+}
+
+func layoutGetJustify(ptr LayoutPtr) bool {
+	return C.pango_layout_get_justify(ptr) != 0
+}
+
+func layoutSetSpacing(ptr LayoutPtr, spacing float64) {
+	C.pango_layout_set_spacing(ptr, C.pango_units_from_double(C.double(spacing)))
+}
+
+func layoutGetSpacing(ptr LayoutPtr) float64 {
+	return fromUnits(C.pango_layout_get_spacing(ptr))
+}
+
+func layoutGetExtents(ptr LayoutPtr) (Rectangle, Rectangle) {
+	var ink, logical C.PangoRectangle
+	C.pango_layout_get_extents(ptr, &ink, &logical)
+	return rectangleFromC(ink), rectangleFromC(logical)
+}
+
+func layoutGetLineCount(ptr LayoutPtr) int {
+	return int(C.pango_layout_get_line_count(ptr))
+}
+
+func layoutUpdate(cr unsafe.Pointer, ptr LayoutPtr) {
+	C.pango_cairo_update_layout((*C.cairo_t)(cr), ptr)
+}
+
+func layoutShow(cr unsafe.Pointer, ptr LayoutPtr) {
+	C.pango_cairo_show_layout((*C.cairo_t)(cr), ptr)
+}
+
+func fromUnits(units C.int) float64 {
+	return float64(C.pango_units_to_double(units))
+}
+
+func rectangleFromC(r C.PangoRectangle) Rectangle {
+	return Rectangle{
+		X:      fromUnits(r.x),
+		Y:      fromUnits(r.y),
+		Width:  fromUnits(r.width),
+		Height: fromUnits(r.height),
+	}
+}
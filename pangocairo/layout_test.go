@@ -0,0 +1,186 @@
+// ABOUTME: Tests for Layout: text and markup, font descriptions, wrapping, alignment, extents and drawing.
+// ABOUTME: Uses whatever fonts fontconfig finds on the host, so measurements are compared rather than fixed.
+
+//go:build pango
+
+package pangocairo
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const paragraph = "The quick brown fox jumps over the lazy dog while the report is written."
+
+// newTestLayout returns a layout with a 12-point sans-serif font for a
+// context on a 400×200 image surface.
+func newTestLayout(t *testing.T) (*Layout, *context.Context, *surface.ImageSurface) {
+	t.Helper()
+
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, 400, 200)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = surf.Close()
+	})
+	ctx, err := context.NewContext(surf)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ctx.Close()
+	})
+
+	l, err := NewLayout(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	l.SetFontDescription("Sans 12")
+	return l, ctx, surf
+}
+
+// TestLayoutText tests setting plain text and markup.
+func TestLayoutText(t *testing.T) {
+	l, _, _ := newTestLayout(t)
+
+	require.NoError(t, l.SetText("Hello"))
+	assert.Equal(t, "Hello", l.GetText())
+
+	require.NoError(t, l.SetMarkup("<b>Hello</b> &amp; <i>bye</i>"))
+	assert.Equal(t, "Hello & bye", l.GetText())
+
+	assert.Equal(t, status.InvalidString, l.SetMarkup("<b>unclosed"))
+	assert.Equal(t, "Hello & bye", l.GetText(), "invalid markup leaves the text unchanged")
+	assert.Equal(t, status.InvalidString, l.SetText("a\xff"))
+	assert.Equal(t, status.InvalidString, l.SetMarkup("a\xff"))
+}
+
+// TestLayoutFontDescription tests that font descriptions are stored and
+// change the size of the text.
+func TestLayoutFontDescription(t *testing.T) {
+	l, _, _ := newTestLayout(t)
+	require.NoError(t, l.SetText("Hello"))
+
+	assert.Equal(t, "Sans 12", l.GetFontDescription())
+	_, small := l.GetSize()
+
+	l.SetFontDescription("Sans Bold 24")
+	assert.Equal(t, "Sans Bold 24", l.GetFontDescription())
+	_, large := l.GetSize()
+	assert.Greater(t, large, small)
+
+	l.SetFontDescription("")
+	assert.Empty(t, l.GetFontDescription())
+}
+
+// TestLayoutWrap tests that text wraps to the width and paragraphs break
+// lines.
+func TestLayoutWrap(t *testing.T) {
+	l, _, _ := newTestLayout(t)
+	require.NoError(t, l.SetText(paragraph))
+
+	assert.Equal(t, -1.0, l.GetWidth())
+	assert.Equal(t, 1, l.GetLineCount())
+	_, oneLine := l.GetSize()
+
+	l.SetWidth(120)
+	assert.InDelta(t, 120.0, l.GetWidth(), 1e-3)
+	assert.Greater(t, l.GetLineCount(), 1)
+	width, height := l.GetSize()
+	assert.LessOrEqual(t, width, 120.0)
+	assert.Greater(t, height, oneLine)
+
+	l.SetWrap(WrapChar)
+	assert.Equal(t, WrapChar, l.GetWrap())
+
+	l.SetWidth(-1)
+	require.NoError(t, l.SetText("first\nsecond\nthird"))
+	assert.Equal(t, 3, l.GetLineCount())
+
+	l.SetSpacing(10)
+	assert.InDelta(t, 10.0, l.GetSpacing(), 1e-3)
+	_, spaced := l.GetSize()
+	l.SetSpacing(0)
+	_, unspaced := l.GetSize()
+	assert.InDelta(t, 20.0, spaced-unspaced, 0.01, "spacing is added between each pair of lines")
+}
+
+// TestLayoutAlignment tests that alignment moves lines within the width.
+func TestLayoutAlignment(t *testing.T) {
+	l, _, _ := newTestLayout(t)
+	require.NoError(t, l.SetText("Hello"))
+	l.SetWidth(300)
+
+	inkX := func(align Alignment) float64 {
+		l.SetAlignment(align)
+		assert.Equal(t, align, l.GetAlignment())
+		ink, _ := l.GetExtents()
+		return ink.X
+	}
+	left, center, right := inkX(AlignLeft), inkX(AlignCenter), inkX(AlignRight)
+	assert.Less(t, left, center)
+	assert.Less(t, center, right)
+
+	l.SetJustify(true)
+	assert.True(t, l.GetJustify())
+}
+
+// TestLayoutShow tests that the layout is drawn at the current point with
+// the context's source.
+func TestLayoutShow(t *testing.T) {
+	l, ctx, surf := newTestLayout(t)
+	l.SetFontDescription("Sans Bold 40")
+	require.NoError(t, l.SetText("W"))
+
+	ink, _ := l.GetExtents()
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.MoveTo(100, 50)
+	require.NoError(t, l.Show())
+	surf.Flush()
+
+	img, err := surf.Image()
+	require.NoError(t, err)
+	// painted reports whether any pixel in the rectangle is drawn, and
+	// fails the test if a drawn pixel is not red.
+	painted := func(x0, y0, x1, y1 float64) bool {
+		found := false
+		for y := int(y0); y < int(y1); y++ {
+			for x := int(x0); x < int(x1); x++ {
+				r, g, b, a := img.At(x, y).RGBA()
+				if a != 0 {
+					found = true
+					assert.True(t, r > 0 && g == 0 && b == 0, "pixel (%d, %d) should be red", x, y)
+				}
+			}
+		}
+		return found
+	}
+	assert.True(t, painted(100+ink.X, 50+ink.Y, 100+ink.X+ink.Width, 50+ink.Y+ink.Height), "the glyph is drawn at the current point")
+	assert.False(t, painted(0, 0, 100+ink.X-1, 200), "nothing is drawn left of the ink")
+}
+
+// TestLayoutClosed tests closed layouts and contexts.
+func TestLayoutClosed(t *testing.T) {
+	l, ctx, _ := newTestLayout(t)
+	require.NoError(t, l.SetText("Hello"))
+
+	require.NoError(t, ctx.Close())
+	assert.Equal(t, status.NullPointer, l.Update())
+	assert.Equal(t, status.NullPointer, l.Show())
+	assert.Equal(t, "Hello", l.GetText(), "the layout outlives its context")
+
+	require.NoError(t, l.Close())
+	require.NoError(t, l.Close())
+	assert.Nil(t, l.Ptr())
+	assert.Equal(t, status.NullPointer, l.SetText("x"))
+	assert.Equal(t, status.NullPointer, l.Show())
+	assert.Empty(t, l.GetText())
+	assert.Zero(t, l.GetLineCount())
+	l.SetWidth(100)
+
+	_, err := NewLayout(ctx)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,143 @@
+// ABOUTME: Tests for ConicGradient: color stop ordering and read-back, the mesh built from the stops,
+// ABOUTME: and preservation of pattern attributes when the mesh is rebuilt.
+package pattern
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Gradient = (*ConicGradient)(nil)
+
+// TestNewConicGradient verifies a new conic gradient is a valid, empty mesh.
+func TestNewConicGradient(t *testing.T) {
+	cg, err := NewConicGradient(50, 50, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = cg.Close()
+	}()
+
+	assert.Equal(t, status.Success, cg.Status())
+	assert.Equal(t, PatternTypeMesh, cg.GetType())
+
+	count, err := cg.GetColorStopCount()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	patches, st := meshGetPatchCount(cg.ptr)
+	require.Equal(t, status.Success, st)
+	assert.Equal(t, 0, patches, "a gradient without stops should paint nothing")
+}
+
+// TestConicGradientColorStops verifies stops are kept in offset order, with
+// equal offsets in insertion order, and that values are clamped.
+func TestConicGradientColorStops(t *testing.T) {
+	cg, err := NewConicGradient(0, 0, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = cg.Close()
+	}()
+
+	cg.AddColorStopRGB(0.5, 0, 0, 1)
+	cg.AddColorStopRGBA(0.0, 1, 0, 0, 0.5)
+	cg.AddColorStopRGB(0.5, 0, 1, 0)
+	cg.AddColorStopRGB(1.5, 2, 0, 0)
+
+	count, err := cg.GetColorStopCount()
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+
+	expected := [][5]float64{
+		{0.0, 1, 0, 0, 0.5},
+		{0.5, 0, 0, 1, 1},
+		{0.5, 0, 1, 0, 1},
+		{1.0, 1, 0, 0, 1},
+	}
+	for i, want := range expected {
+		o, r, g, b, a, err := cg.GetColorStopRGBA(i)
+		require.NoError(t, err)
+		assert.Equal(t, want, [5]float64{o, r, g, b, a}, "stop %d", i)
+	}
+
+	_, _, _, _, _, err = cg.GetColorStopRGBA(4)
+	assert.Equal(t, status.InvalidIndex, err)
+	_, _, _, _, _, err = cg.GetColorStopRGBA(-1)
+	assert.Equal(t, status.InvalidIndex, err)
+}
+
+// TestConicGradientMesh verifies the mesh covers the full turn with patches
+// no wider than conicMaxSweep, colored from the stops.
+func TestConicGradientMesh(t *testing.T) {
+	cg, err := NewConicGradient(10, 20, math.Pi/2)
+	require.NoError(t, err)
+	defer func() {
+		_ = cg.Close()
+	}()
+
+	cg.AddColorStopRGB(0, 1, 0, 0)
+	cg.AddColorStopRGB(1, 0, 0, 1)
+	require.Equal(t, status.Success, cg.Status())
+
+	patches, st := meshGetPatchCount(cg.ptr)
+	require.Equal(t, status.Success, st)
+	assert.Equal(t, int(math.Round(2*math.Pi/conicMaxSweep)), patches)
+
+	// The first patch starts at the center and heads along the start angle.
+	path, err := meshGetPath(cg.ptr, 0)
+	require.NoError(t, err)
+	assert.Equal(t, MeshPoint{10, 20}, path.Start)
+	assert.InDelta(t, 10, path.Sides[0][2].X, 1e-6)
+	assert.InDelta(t, 20+conicRadius, path.Sides[0][2].Y, 1e-6)
+
+	r, g, b, a, st := meshGetCornerColorRGBA(cg.ptr, 0, 0)
+	require.Equal(t, status.Success, st)
+	assert.Equal(t, []float64{1, 0, 0, 1}, []float64{r, g, b, a})
+
+	r, g, b, a, st = meshGetCornerColorRGBA(cg.ptr, patches-1, 2)
+	require.Equal(t, status.Success, st)
+	assert.Equal(t, []float64{0, 0, 1, 1}, []float64{r, g, b, a})
+}
+
+// TestConicGradientRebuildKeepsAttributes verifies the matrix, extend and
+// filter survive adding stops.
+func TestConicGradientRebuildKeepsAttributes(t *testing.T) {
+	cg, err := NewConicGradient(0, 0, 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = cg.Close()
+	}()
+
+	cg.SetMatrix(matrix.NewTranslationMatrix(5, 7))
+	cg.SetFilter(FilterNearest)
+	cg.SetExtend(ExtendPad)
+
+	cg.AddColorStopRGB(0, 1, 1, 1)
+
+	m, err := cg.GetMatrix()
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, m.X0)
+	assert.Equal(t, 7.0, m.Y0)
+	assert.Equal(t, FilterNearest, cg.GetFilter())
+	assert.Equal(t, ExtendPad, cg.GetExtend())
+}
+
+// TestConicGradientClosed verifies methods on a closed conic gradient are safe.
+func TestConicGradientClosed(t *testing.T) {
+	cg, err := NewConicGradient(0, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, cg.Close())
+
+	cg.AddColorStopRGB(0, 1, 0, 0)
+	cg.AddColorStopRGBA(1, 1, 0, 0, 1)
+
+	assert.Equal(t, status.NullPointer, cg.Status())
+	_, err = cg.GetColorStopCount()
+	assert.Equal(t, status.NullPointer, err)
+	_, _, _, _, _, err = cg.GetColorStopRGBA(0)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -11,7 +11,9 @@
 //   - Linear gradients (planned)
 //   - Radial gradients (planned)
 //   - Surface patterns (for texturing with images) (planned)
-//   - Mesh patterns (for complex gradients) (planned)
+//   - Mesh patterns (for free-form gradients)
+//   - Conic (sweep) gradients, built on mesh patterns
+//   - Raster source patterns (pixels supplied on demand by Go callbacks)
 //
 // # Pattern Types
 //
@@ -35,6 +37,29 @@
 //	}
 //	defer pattern.Close()
 //
+// Mesh Patterns:
+//
+// A mesh pattern is built from four-sided patches with a color at each
+// corner, which Cairo blends smoothly across the patch. Each patch is
+// defined between BeginPatch and EndPatch:
+//
+//	mesh, err := pattern.NewMeshPattern()
+//	if err != nil {
+//	    return err
+//	}
+//	defer mesh.Close()
+//
+//	mesh.BeginPatch()
+//	mesh.MoveTo(0, 0)
+//	mesh.LineTo(100, 0)
+//	mesh.LineTo(100, 100)
+//	mesh.LineTo(0, 100)
+//	mesh.SetCornerColorRGB(0, 1, 0, 0)
+//	mesh.SetCornerColorRGB(1, 0, 1, 0)
+//	mesh.SetCornerColorRGB(2, 0, 0, 1)
+//	mesh.SetCornerColorRGB(3, 1, 1, 0)
+//	mesh.EndPatch()
+//
 // # Using Patterns with Context
 //
 // Patterns are used as the "source" for drawing operations. Set a pattern
@@ -0,0 +1,50 @@
+// ABOUTME: Exported callbacks that Cairo invokes to serve raster source patterns from Go state.
+// ABOUTME: Exports the acquire, release and snapshot callbacks, and the copy and finish hooks that count references.
+
+package pattern
+
+// #include <cairo.h>
+import "C"
+
+import (
+	"image"
+	"runtime/cgo"
+	"unsafe"
+)
+
+func rasterSourceStateFor(data unsafe.Pointer) *rasterSourceState {
+	return cgo.Handle(uintptr(data)).Value().(*rasterSourceState)
+}
+
+//export goRasterSourceAcquire
+func goRasterSourceAcquire(data unsafe.Pointer, x, y, width, height C.int) *C.cairo_surface_t {
+	extents := image.Rect(int(x), int(y), int(x+width), int(y+height))
+	s := rasterSourceStateFor(data).acquire(extents)
+	if s == nil {
+		return nil
+	}
+	return (*C.cairo_surface_t)(unsafe.Pointer(s.Ptr())) //nolint:gosec
+}
+
+//export goRasterSourceRelease
+func goRasterSourceRelease(data unsafe.Pointer, surface *C.cairo_surface_t) {
+	rasterSourceStateFor(data).release(unsafe.Pointer(surface))
+}
+
+//export goRasterSourceSnapshot
+func goRasterSourceSnapshot(data unsafe.Pointer) C.cairo_status_t {
+	return C.cairo_status_t(rasterSourceStateFor(data).snapshot())
+}
+
+//export goRasterSourceRetain
+func goRasterSourceRetain(data unsafe.Pointer) {
+	rasterSourceStateFor(data).refs.Add(1)
+}
+
+//export goRasterSourceFinish
+func goRasterSourceFinish(data unsafe.Pointer) {
+	h := cgo.Handle(uintptr(data))
+	if h.Value().(*rasterSourceState).refs.Add(-1) == 0 {
+		h.Delete()
+	}
+}
@@ -0,0 +1,264 @@
+// ABOUTME: MeshPattern implementation for free-form gradients built from Coons and tensor-product patches.
+// ABOUTME: Provides patch construction (BeginPatch/EndPatch, path and color setters) and patch read-back.
+package pattern
+
+import "github.com/mikowitz/cairo/status"
+
+// MeshPoint is a point in pattern space, used for the boundary and control
+// points of a mesh patch.
+type MeshPoint struct {
+	X, Y float64
+}
+
+// PatchPath is the boundary of a mesh patch as returned by
+// [MeshPattern.GetPath]. Cairo stores every side of a patch as a cubic
+// Bézier curve, so sides added with LineTo are reported as curves whose
+// control points lie on the line.
+type PatchPath struct {
+	// Start is the first corner of the patch (corner 0).
+	Start MeshPoint
+
+	// Sides holds the four sides in order, each as its two Bézier control
+	// points followed by its end point. The end point of side i is
+	// corner i+1, and the last side ends back at Start.
+	Sides [4][3]MeshPoint
+}
+
+// MeshPattern represents a mesh gradient pattern.
+//
+// A mesh gradient is made of one or more patches. Each patch is a
+// four-sided region bounded by cubic Bézier curves, with a color at each of
+// its four corners; Cairo interpolates the colors smoothly across the patch.
+// This allows free-form gradients that linear and radial gradients cannot
+// express, such as heatmap overlays and realistic shading.
+//
+// Patches are defined with a path-like API between [MeshPattern.BeginPatch]
+// and [MeshPattern.EndPatch]. The sides are added with MoveTo, LineTo and
+// CurveTo, and the corners are numbered 0 to 3 in the order they are added
+// to the path. Each patch may also have four interior control points, which
+// turn a Coons patch into a tensor-product patch; by default they are
+// computed from the sides.
+//
+// Mistakes while constructing a patch, such as calling LineTo outside a
+// patch or adding more than four sides, put the pattern into an error state
+// with status.InvalidMeshConstruction, which is reported by Status.
+//
+// MeshPattern embeds BasePattern and implements the Pattern interface, so it
+// can be used with Context.SetSource like any other pattern.
+//
+// Example:
+//
+//	mesh, err := pattern.NewMeshPattern()
+//	if err != nil {
+//	    return err
+//	}
+//	defer mesh.Close()
+//
+//	mesh.BeginPatch()
+//	mesh.MoveTo(0, 0)
+//	mesh.LineTo(100, 0)
+//	mesh.LineTo(100, 100)
+//	mesh.LineTo(0, 100)
+//	mesh.SetCornerColorRGB(0, 1, 0, 0) // Red
+//	mesh.SetCornerColorRGB(1, 0, 1, 0) // Green
+//	mesh.SetCornerColorRGB(2, 0, 0, 1) // Blue
+//	mesh.SetCornerColorRGB(3, 1, 1, 0) // Yellow
+//	mesh.EndPatch()
+//
+//	ctx.SetSource(mesh)
+//	ctx.Paint()
+type MeshPattern struct {
+	*BasePattern
+}
+
+// NewMeshPattern creates a new, empty mesh pattern. Add patches with
+// BeginPatch, path operations, corner colors and EndPatch before using it.
+// A mesh pattern with no patches paints nothing.
+//
+// The returned pattern must be closed with Close() when finished to release
+// Cairo resources.
+func NewMeshPattern() (*MeshPattern, error) {
+	ptr := patternCreateMesh()
+	st := patternStatus(ptr)
+
+	if st != status.Success {
+		return nil, st
+	}
+
+	basePattern := newBasePattern(ptr, PatternTypeMesh)
+	return &MeshPattern{
+		BasePattern: basePattern,
+	}, nil
+}
+
+// BeginPatch starts a new patch. Its shape is then defined with MoveTo,
+// LineTo and CurveTo, its colors with SetCornerColorRGB or
+// SetCornerColorRGBA, and it is added to the mesh by EndPatch.
+//
+// Calling BeginPatch while a patch is already in progress puts the pattern
+// into an error state with status.InvalidMeshConstruction.
+func (m *MeshPattern) BeginPatch() {
+	m.withLock(func() {
+		meshBeginPatch(m.ptr)
+	})
+}
+
+// EndPatch finishes the current patch and adds it to the mesh.
+//
+// If fewer than four sides were added, the patch is closed with straight
+// lines back to the starting point. Corners without an explicit color are
+// transparent black. Calling EndPatch with no patch in progress, or before
+// the first MoveTo, puts the pattern into an error state with
+// status.InvalidMeshConstruction.
+func (m *MeshPattern) EndPatch() {
+	m.withLock(func() {
+		meshEndPatch(m.ptr)
+	})
+}
+
+// MoveTo sets the starting point of the current patch, which becomes
+// corner 0. It must be called once, before any other path operation.
+func (m *MeshPattern) MoveTo(x, y float64) {
+	m.withLock(func() {
+		meshMoveTo(m.ptr, x, y)
+	})
+}
+
+// LineTo adds a straight side from the current point to (x, y). If there
+// is no current point, it behaves like MoveTo.
+func (m *MeshPattern) LineTo(x, y float64) {
+	m.withLock(func() {
+		meshLineTo(m.ptr, x, y)
+	})
+}
+
+// CurveTo adds a cubic Bézier side from the current point to (x3, y3),
+// using (x1, y1) and (x2, y2) as control points. If there is no current
+// point, (x1, y1) is used as the starting point.
+func (m *MeshPattern) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	m.withLock(func() {
+		meshCurveTo(m.ptr, x1, y1, x2, y2, x3, y3)
+	})
+}
+
+// SetControlPoint sets interior control point point (0 to 3) of the
+// current patch to (x, y). Control point i lies near corner i. Patches with
+// no explicit control points are Coons patches; setting them makes the
+// patch a tensor-product patch.
+func (m *MeshPattern) SetControlPoint(point int, x, y float64) {
+	m.withLock(func() {
+		meshSetControlPoint(m.ptr, point, x, y)
+	})
+}
+
+// SetCornerColorRGB sets the color of corner (0 to 3) of the current patch
+// to an opaque color. Components are in the range [0.0, 1.0].
+func (m *MeshPattern) SetCornerColorRGB(corner int, r, g, b float64) {
+	m.withLock(func() {
+		meshSetCornerColorRGB(m.ptr, corner, r, g, b)
+	})
+}
+
+// SetCornerColorRGBA sets the color of corner (0 to 3) of the current patch
+// to a translucent color. Components are in the range [0.0, 1.0].
+func (m *MeshPattern) SetCornerColorRGBA(corner int, r, g, b, a float64) {
+	m.withLock(func() {
+		meshSetCornerColorRGBA(m.ptr, corner, r, g, b, a)
+	})
+}
+
+// PatchCount returns the number of completed patches in the mesh. A patch
+// that has been begun but not ended is not counted.
+//
+// Returns status.NullPointer if the pattern has been closed.
+func (m *MeshPattern) PatchCount() (int, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.ptr == nil {
+		return 0, status.NullPointer
+	}
+
+	count, st := meshGetPatchCount(m.ptr)
+	if st != status.Success {
+		return count, st
+	}
+	return count, nil
+}
+
+// GetPath returns the boundary of the completed patch at index patch.
+//
+// Returns status.NullPointer if the pattern has been closed, or
+// status.InvalidIndex if patch is not a valid patch index.
+//
+// Example:
+//
+//	p, err := mesh.GetPath(0)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("patch starts at (%.1f, %.1f)\n", p.Start.X, p.Start.Y)
+func (m *MeshPattern) GetPath(patch int) (*PatchPath, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.ptr == nil {
+		return nil, status.NullPointer
+	}
+
+	return meshGetPath(m.ptr, patch)
+}
+
+// GetControlPoint returns interior control point point (0 to 3) of the
+// completed patch at index patch. For Coons patches these are the points
+// Cairo computed from the sides.
+//
+// Returns status.NullPointer if the pattern has been closed, or
+// status.InvalidIndex if patch or point is out of range.
+func (m *MeshPattern) GetControlPoint(patch, point int) (float64, float64, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.ptr == nil {
+		return 0, 0, status.NullPointer
+	}
+
+	x, y, st := meshGetControlPoint(m.ptr, patch, point)
+	if st != status.Success {
+		return x, y, st
+	}
+	return x, y, nil
+}
+
+// GetCornerColor returns the color of corner (0 to 3) of the completed
+// patch at index patch, as unpremultiplied red, green, blue and alpha
+// components.
+//
+// Returns status.NullPointer if the pattern has been closed, or
+// status.InvalidIndex if patch or corner is out of range.
+func (m *MeshPattern) GetCornerColor(patch, corner int) (float64, float64, float64, float64, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.ptr == nil {
+		return 0, 0, 0, 0, status.NullPointer
+	}
+
+	r, g, b, a, st := meshGetCornerColorRGBA(m.ptr, patch, corner)
+	if st != status.Success {
+		return r, g, b, a, st
+	}
+	return r, g, b, a, nil
+}
+
+// withLock runs fn with the pattern locked, skipping it if the pattern has
+// been closed.
+func (m *MeshPattern) withLock(fn func()) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.ptr == nil {
+		return
+	}
+	fn()
+}
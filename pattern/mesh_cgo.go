@@ -0,0 +1,135 @@
+package pattern
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+func patternCreateMesh() PatternPtr {
+	return C.cairo_pattern_create_mesh()
+}
+
+func meshBeginPatch(ptr PatternPtr) {
+	C.cairo_mesh_pattern_begin_patch(ptr)
+}
+
+func meshEndPatch(ptr PatternPtr) {
+	C.cairo_mesh_pattern_end_patch(ptr)
+}
+
+func meshMoveTo(ptr PatternPtr, x, y float64) {
+	C.cairo_mesh_pattern_move_to(ptr, C.double(x), C.double(y))
+}
+
+func meshLineTo(ptr PatternPtr, x, y float64) {
+	C.cairo_mesh_pattern_line_to(ptr, C.double(x), C.double(y))
+}
+
+func meshCurveTo(ptr PatternPtr, x1, y1, x2, y2, x3, y3 float64) {
+	C.cairo_mesh_pattern_curve_to(ptr,
+		C.double(x1), C.double(y1),
+		C.double(x2), C.double(y2),
+		C.double(x3), C.double(y3),
+	)
+}
+
+func meshSetControlPoint(ptr PatternPtr, point int, x, y float64) {
+	C.cairo_mesh_pattern_set_control_point(ptr, C.uint(point), C.double(x), C.double(y))
+}
+
+func meshSetCornerColorRGB(ptr PatternPtr, corner int, r, g, b float64) {
+	C.cairo_mesh_pattern_set_corner_color_rgb(ptr, C.uint(corner),
+		C.double(r), C.double(g), C.double(b),
+	)
+}
+
+func meshSetCornerColorRGBA(ptr PatternPtr, corner int, r, g, b, a float64) {
+	C.cairo_mesh_pattern_set_corner_color_rgba(ptr, C.uint(corner),
+		C.double(r), C.double(g), C.double(b), C.double(a),
+	)
+}
+
+func meshGetPatchCount(ptr PatternPtr) (int, status.Status) {
+	var count C.uint
+
+	st := C.cairo_mesh_pattern_get_patch_count(ptr, &count)
+
+	return int(count), status.Status(st)
+}
+
+func meshGetControlPoint(ptr PatternPtr, patch, point int) (float64, float64, status.Status) {
+	if patch < 0 || point < 0 {
+		return 0, 0, status.InvalidIndex
+	}
+
+	var x, y C.double
+
+	st := C.cairo_mesh_pattern_get_control_point(ptr, C.uint(patch), C.uint(point), &x, &y)
+
+	return float64(x), float64(y), status.Status(st)
+}
+
+func meshGetCornerColorRGBA(ptr PatternPtr, patch, corner int) (float64, float64, float64, float64, status.Status) {
+	if patch < 0 || corner < 0 {
+		return 0, 0, 0, 0, status.InvalidIndex
+	}
+
+	var r, g, b, a C.double
+
+	st := C.cairo_mesh_pattern_get_corner_color_rgba(ptr, C.uint(patch), C.uint(corner), &r, &g, &b, &a)
+
+	return float64(r), float64(g), float64(b), float64(a), status.Status(st)
+}
+
+// meshPathHeader and meshPathPoint mirror the two members of the
+// cairo_path_data_t union, which cgo exposes only as raw bytes.
+type meshPathHeader struct {
+	typ    C.cairo_path_data_type_t
+	length C.int
+}
+
+type meshPathPoint struct {
+	x, y C.double
+}
+
+// meshGetPath converts the patch boundary, which Cairo returns as a move-to
+// followed by four curve-to elements, into a PatchPath.
+func meshGetPath(ptr PatternPtr, patch int) (*PatchPath, error) {
+	if patch < 0 {
+		return nil, status.InvalidIndex
+	}
+
+	cPath := C.cairo_mesh_pattern_get_path(ptr, C.uint(patch))
+	defer C.cairo_path_destroy(cPath)
+
+	if st := status.Status(cPath.status); st != status.Success {
+		return nil, st
+	}
+
+	var points []MeshPoint
+	data := unsafe.Slice(cPath.data, int(cPath.num_data))
+	for i := 0; i < len(data); {
+		header := (*meshPathHeader)(unsafe.Pointer(&data[i]))
+		length := int(header.length)
+		for j := 1; j < length; j++ {
+			pt := (*meshPathPoint)(unsafe.Pointer(&data[i+j]))
+			points = append(points, MeshPoint{X: float64(pt.x), Y: float64(pt.y)})
+		}
+		i += length
+	}
+
+	if len(points) != 13 {
+		return nil, status.InvalidPathData
+	}
+
+	path := &PatchPath{Start: points[0]}
+	for side := range path.Sides {
+		copy(path.Sides[side][:], points[1+side*3:])
+	}
+	return path, nil
+}
@@ -0,0 +1,245 @@
+// ABOUTME: Tests for MeshPattern: patch construction, read-back of paths, colors and control points,
+// ABOUTME: and error handling for invalid construction, invalid indices and closed patterns.
+package pattern
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMeshPattern returns a mesh with a single 100x100 square patch whose
+// corners are red, green, blue and translucent white.
+func newTestMeshPattern(t *testing.T) *MeshPattern {
+	t.Helper()
+
+	mesh, err := NewMeshPattern()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = mesh.Close()
+	})
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(100, 0)
+	mesh.LineTo(100, 100)
+	mesh.LineTo(0, 100)
+	mesh.SetCornerColorRGB(0, 1, 0, 0)
+	mesh.SetCornerColorRGB(1, 0, 1, 0)
+	mesh.SetCornerColorRGB(2, 0, 0, 1)
+	mesh.SetCornerColorRGBA(3, 1, 1, 1, 0.5)
+	mesh.EndPatch()
+	require.Equal(t, status.Success, mesh.Status())
+
+	return mesh
+}
+
+// TestNewMeshPattern verifies a new mesh pattern is valid and empty.
+func TestNewMeshPattern(t *testing.T) {
+	mesh, err := NewMeshPattern()
+	require.NoError(t, err)
+	defer func() {
+		_ = mesh.Close()
+	}()
+
+	assert.Equal(t, status.Success, mesh.Status())
+	assert.Equal(t, PatternTypeMesh, mesh.GetType())
+
+	count, err := mesh.PatchCount()
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+// TestMeshPatternPatchCount verifies only completed patches are counted.
+func TestMeshPatternPatchCount(t *testing.T) {
+	mesh := newTestMeshPattern(t)
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(10, 0)
+	count, err := mesh.PatchCount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "a patch in progress should not be counted")
+
+	mesh.EndPatch()
+	count, err = mesh.PatchCount()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+// TestMeshPatternGetCornerColor verifies corner colors round-trip, and that
+// corners without a color are transparent black.
+func TestMeshPatternGetCornerColor(t *testing.T) {
+	mesh := newTestMeshPattern(t)
+
+	tests := []struct {
+		corner     int
+		r, g, b, a float64
+	}{
+		{0, 1, 0, 0, 1},
+		{1, 0, 1, 0, 1},
+		{2, 0, 0, 1, 1},
+		{3, 1, 1, 1, 0.5},
+	}
+	for _, tt := range tests {
+		r, g, b, a, err := mesh.GetCornerColor(0, tt.corner)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{tt.r, tt.g, tt.b, tt.a}, []float64{r, g, b, a}, "corner %d", tt.corner)
+	}
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(10, 0)
+	mesh.LineTo(10, 10)
+	mesh.EndPatch()
+	r, g, b, a, err := mesh.GetCornerColor(1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 0, 0, 0}, []float64{r, g, b, a})
+}
+
+// TestMeshPatternGetPath verifies the patch boundary is reported as a
+// starting corner and four sides ending at the remaining corners.
+func TestMeshPatternGetPath(t *testing.T) {
+	mesh, err := NewMeshPattern()
+	require.NoError(t, err)
+	defer func() {
+		_ = mesh.Close()
+	}()
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.CurveTo(30, -20, 70, 20, 100, 0)
+	mesh.LineTo(100, 100)
+	mesh.LineTo(0, 100)
+	mesh.EndPatch()
+
+	path, err := mesh.GetPath(0)
+	require.NoError(t, err)
+
+	assert.Equal(t, MeshPoint{0, 0}, path.Start)
+	assert.Equal(t, [3]MeshPoint{{30, -20}, {70, 20}, {100, 0}}, path.Sides[0])
+	assert.Equal(t, MeshPoint{100, 100}, path.Sides[1][2])
+	assert.Equal(t, MeshPoint{0, 100}, path.Sides[2][2])
+	assert.Equal(t, MeshPoint{0, 0}, path.Sides[3][2], "the implicit fourth side should close the patch")
+
+	// Straight sides are reported as curves with control points on the line.
+	for _, pt := range path.Sides[1][:2] {
+		assert.InDelta(t, 100, pt.X, 1e-9)
+	}
+}
+
+// TestMeshPatternControlPoints verifies explicit control points round-trip.
+func TestMeshPatternControlPoints(t *testing.T) {
+	mesh, err := NewMeshPattern()
+	require.NoError(t, err)
+	defer func() {
+		_ = mesh.Close()
+	}()
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(100, 0)
+	mesh.LineTo(100, 100)
+	mesh.LineTo(0, 100)
+	mesh.SetControlPoint(0, 20, 20)
+	mesh.SetControlPoint(2, 60, 70)
+	mesh.EndPatch()
+	require.Equal(t, status.Success, mesh.Status())
+
+	x, y, err := mesh.GetControlPoint(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{20, 20}, []float64{x, y})
+
+	x, y, err = mesh.GetControlPoint(0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{60, 70}, []float64{x, y})
+}
+
+// TestMeshPatternInvalidConstruction verifies construction mistakes put the
+// pattern into an error state.
+func TestMeshPatternInvalidConstruction(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(m *MeshPattern)
+	}{
+		{"EndWithoutBegin", func(m *MeshPattern) {
+			m.EndPatch()
+		}},
+		{"NestedBegin", func(m *MeshPattern) {
+			m.BeginPatch()
+			m.BeginPatch()
+		}},
+		{"LineOutsidePatch", func(m *MeshPattern) {
+			m.LineTo(10, 10)
+		}},
+		{"TooManySides", func(m *MeshPattern) {
+			m.BeginPatch()
+			m.MoveTo(0, 0)
+			for range 5 {
+				m.LineTo(10, 10)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mesh, err := NewMeshPattern()
+			require.NoError(t, err)
+			defer func() {
+				_ = mesh.Close()
+			}()
+
+			tt.build(mesh)
+			assert.Equal(t, status.InvalidMeshConstruction, mesh.Status())
+		})
+	}
+}
+
+// TestMeshPatternInvalidIndex verifies read-back with out-of-range indices
+// returns status.InvalidIndex.
+func TestMeshPatternInvalidIndex(t *testing.T) {
+	mesh := newTestMeshPattern(t)
+
+	_, err := mesh.GetPath(1)
+	assert.Equal(t, status.InvalidIndex, err)
+	_, err = mesh.GetPath(-1)
+	assert.Equal(t, status.InvalidIndex, err)
+
+	_, _, _, _, err = mesh.GetCornerColor(0, 4)
+	assert.Equal(t, status.InvalidIndex, err)
+	_, _, _, _, err = mesh.GetCornerColor(1, 0)
+	assert.Equal(t, status.InvalidIndex, err)
+
+	_, _, err = mesh.GetControlPoint(0, 4)
+	assert.Equal(t, status.InvalidIndex, err)
+	_, _, err = mesh.GetControlPoint(-1, 0)
+	assert.Equal(t, status.InvalidIndex, err)
+}
+
+// TestMeshPatternClosed verifies methods on a closed mesh pattern are safe.
+func TestMeshPatternClosed(t *testing.T) {
+	mesh, err := NewMeshPattern()
+	require.NoError(t, err)
+	require.NoError(t, mesh.Close())
+
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(10, 0)
+	mesh.CurveTo(1, 2, 3, 4, 5, 6)
+	mesh.SetControlPoint(0, 1, 1)
+	mesh.SetCornerColorRGB(0, 1, 0, 0)
+	mesh.SetCornerColorRGBA(0, 1, 0, 0, 1)
+	mesh.EndPatch()
+
+	assert.Equal(t, status.NullPointer, mesh.Status())
+	_, err = mesh.PatchCount()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = mesh.GetPath(0)
+	assert.Equal(t, status.NullPointer, err)
+	_, _, err = mesh.GetControlPoint(0, 0)
+	assert.Equal(t, status.NullPointer, err)
+	_, _, _, _, err = mesh.GetCornerColor(0, 0)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -304,8 +304,10 @@ func (b *BasePattern) close() error {
 // Currently supported pattern types:
 //   - PatternTypeSolid: Returns a *SolidPattern
 //   - PatternTypeSurface: Returns a *SurfacePattern
+//   - PatternTypeMesh: Returns a *MeshPattern
+//   - PatternTypeRasterSource: Returns a *RasterSourcePattern
 //
-// For unsupported pattern types (Linear, Radial, Mesh, RasterSource), this
+// For unsupported pattern types (Linear, Radial), this
 // function currently defaults to returning the base pattern implementation.
 // This will be updated as additional pattern types are implemented.
 //
@@ -324,6 +326,15 @@ func PatternFromC(uPtr unsafe.Pointer) Pattern {
 		return &SurfacePattern{
 			BasePattern: basePattern,
 		}
+	case PatternTypeMesh:
+		return &MeshPattern{
+			BasePattern: basePattern,
+		}
+	case PatternTypeRasterSource:
+		return &RasterSourcePattern{
+			BasePattern: basePattern,
+			state:       rasterSourceGetState(ptr),
+		}
 	// TODO: Add cases for other pattern types as implemented
 	default:
 		return basePattern
@@ -2,6 +2,18 @@ package pattern
 
 // #cgo pkg-config: cairo
 // #include <cairo.h>
+//
+// // _retiredKey holds the pattern a rebuilt pattern replaced, so that the
+// // old one is destroyed together with its successor.
+// static cairo_user_data_key_t _retiredKey;
+//
+// static void _retiredDestroy(void *p) {
+//     cairo_pattern_destroy((cairo_pattern_t *)p);
+// }
+//
+// static cairo_status_t _patternRetire(cairo_pattern_t *dst, cairo_pattern_t *old) {
+//     return cairo_pattern_set_user_data(dst, &_retiredKey, old, _retiredDestroy);
+// }
 import "C"
 
 import (
@@ -122,3 +134,18 @@ func patternSetFilter(ptr PatternPtr, filter Filter) {
 func patternGetFilter(ptr PatternPtr) Filter {
 	return Filter(C.cairo_pattern_get_filter(ptr))
 }
+
+// patternCopyAttributes copies the matrix, extend and filter of src to dst.
+func patternCopyAttributes(dst, src PatternPtr) {
+	var m C.cairo_matrix_t
+	C.cairo_pattern_get_matrix(src, &m)
+	C.cairo_pattern_set_matrix(dst, &m)
+	C.cairo_pattern_set_extend(dst, C.cairo_pattern_get_extend(src))
+	C.cairo_pattern_set_filter(dst, C.cairo_pattern_get_filter(src))
+}
+
+// patternRetire hands the reference to old over to dst, which releases it
+// when dst itself is destroyed.
+func patternRetire(dst, old PatternPtr) status.Status {
+	return status.Status(C._patternRetire(dst, old))
+}
@@ -0,0 +1,289 @@
+// ABOUTME: RasterSourcePattern implementation: a pattern whose pixels are supplied on demand by Go callbacks.
+// ABOUTME: Tracks surfaces handed to Cairo and records callback errors and panics as a pattern status.
+package pattern
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+)
+
+// RasterSourceFuncs holds the callbacks that supply the pixels of a
+// RasterSourcePattern. Only Acquire is required.
+//
+// Callbacks run on the goroutine performing the drawing operation, while
+// Cairo is in the middle of that operation. They must not draw with the
+// context that is using the pattern.
+type RasterSourceFuncs struct {
+	// Acquire returns an image holding the pattern's pixels for extents, the
+	// area of pattern space that Cairo needs. Pixel (0, 0) of the returned
+	// surface corresponds to extents.Min. Returning a surface that covers
+	// more than extents is allowed; Cairo samples only what it needs. While
+	// Cairo uses the surface, its device offset is set to line it up with
+	// pattern space; the previous offset is restored before Release.
+	//
+	// Some backends, including image surfaces, always request the pattern's
+	// full width and height.
+	Acquire func(extents image.Rectangle) (*surface.ImageSurface, error)
+
+	// Release is called when Cairo has finished with a surface returned by
+	// Acquire. If Release is nil, the surface is closed instead; provide
+	// Release to keep surfaces in a cache.
+	Release func(s *surface.ImageSurface)
+
+	// Snapshot is called when Cairo needs the pattern's content to stay
+	// fixed, for example when the pattern is recorded onto a recording
+	// surface. Sources whose content can change should take a copy of it.
+	// Snapshot may be nil.
+	Snapshot func() error
+}
+
+// RasterSourcePattern represents a pattern whose pixels are generated on
+// demand by Go callbacks, instead of being held in a surface up front.
+//
+// When Cairo draws with the pattern, it calls Acquire for the area it
+// samples, uses the returned image, and then calls Release. This lets
+// applications lazily decode large images, map tiles or video frames, or
+// render procedural content only when and where it is used.
+//
+// If a callback returns an error or panics, the panic is recovered, the
+// affected drawing operation paints nothing, and the pattern's Status
+// reports status.ReadError from then on. Err returns the underlying error.
+//
+// RasterSourcePattern embeds BasePattern and implements the Pattern
+// interface, so it can be used with Context.SetSource like any other
+// pattern.
+//
+// Example:
+//
+//	src, err := pattern.NewRasterSourcePattern(surface.ContentColorAlpha, 4096, 4096,
+//		pattern.RasterSourceFuncs{
+//			Acquire: func(extents image.Rectangle) (*surface.ImageSurface, error) {
+//				return decodeTiles(extents)
+//			},
+//		})
+//	if err != nil {
+//	    return err
+//	}
+//	defer src.Close()
+//
+//	ctx.SetSource(src)
+//	ctx.Paint()
+type RasterSourcePattern struct {
+	*BasePattern
+	state *rasterSourceState
+}
+
+// NewRasterSourcePattern creates a new raster source pattern of the given
+// content and size in pattern space, whose pixels are supplied by funcs.
+//
+// The callbacks are kept alive for as long as Cairo holds a reference to the
+// pattern, which may outlive Close if a context still uses it as its source.
+//
+// Returns status.NullPointer if funcs.Acquire is nil, or the Cairo error
+// (such as status.InvalidSize for negative dimensions) if the pattern cannot
+// be created.
+func NewRasterSourcePattern(content surface.Content, width, height int, funcs RasterSourceFuncs) (*RasterSourcePattern, error) {
+	if funcs.Acquire == nil {
+		return nil, status.NullPointer
+	}
+
+	state := &rasterSourceState{
+		funcs:    funcs,
+		acquired: make(map[unsafe.Pointer]*acquiredSurface),
+	}
+	ptr, st := rasterSourceCreate(state, content, width, height)
+	if st != status.Success {
+		return nil, st
+	}
+
+	basePattern := newBasePattern(ptr, PatternTypeRasterSource)
+	return &RasterSourcePattern{
+		BasePattern: basePattern,
+		state:       state,
+	}, nil
+}
+
+// Status returns the status of the pattern. In addition to the statuses
+// reported by every pattern, it returns status.ReadError once a callback
+// has failed or panicked.
+func (p *RasterSourcePattern) Status() status.Status {
+	if st := p.BasePattern.Status(); st != status.Success {
+		return st
+	}
+	if p.Err() != nil {
+		return status.ReadError
+	}
+	return status.Success
+}
+
+// Err returns the first error returned by a callback, or an error describing
+// the first recovered callback panic. It returns nil if every callback has
+// succeeded so far.
+func (p *RasterSourcePattern) Err() error {
+	if p.state == nil {
+		return nil
+	}
+	return p.state.firstErr()
+}
+
+// acquiredSurface is a surface handed to Cairo by an acquire callback, with
+// the number of times it has been handed out and not yet released.
+type acquiredSurface struct {
+	surf *surface.ImageSurface
+	n    int
+
+	// offsetX and offsetY hold the device offset the surface had before it
+	// was first acquired, restored when it is last released.
+	offsetX, offsetY float64
+
+	// fallback marks the transparent surface used when Acquire fails,
+	// which is always closed on release.
+	fallback bool
+}
+
+// rasterSourceState is the Go state behind a raster source pattern. Cairo
+// holds it through a handle that may be shared by copies of the pattern, so
+// it is reference counted and released when the last copy is finished.
+type rasterSourceState struct {
+	funcs RasterSourceFuncs
+	refs  atomic.Int32
+
+	mu       sync.Mutex
+	acquired map[unsafe.Pointer]*acquiredSurface
+	err      error
+}
+
+func (s *rasterSourceState) firstErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// fail records err, keeping only the first error.
+func (s *rasterSourceState) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// recoverInto turns a panic in a callback into an error stored in *err.
+func recoverInto(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("raster source callback panicked: %v", r)
+	}
+}
+
+// acquire calls the Acquire callback and returns the surface to hand to
+// Cairo. On failure the error is recorded and a transparent surface is
+// returned instead, so the drawing operation paints nothing.
+func (s *rasterSourceState) acquire(extents image.Rectangle) *surface.ImageSurface {
+	surf, err := s.callAcquire(extents)
+	fallback := false
+	if err == nil && surf == nil {
+		err = errors.New("raster source acquire returned a nil surface")
+	}
+	if err != nil {
+		s.fail(err)
+		if surf, err = surface.NewImageSurface(surface.FormatARGB32, 1, 1); err != nil {
+			return nil
+		}
+		fallback = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := unsafe.Pointer(surf.Ptr()) //nolint:gosec
+	a, ok := s.acquired[key]
+	if !ok {
+		a = &acquiredSurface{surf: surf, fallback: fallback}
+		a.offsetX, a.offsetY = surfaceDeviceOffset(surf)
+		s.acquired[key] = a
+	}
+	a.n++
+
+	// Always set the offset, even for the origin, since a cached surface may
+	// still carry the offset of an earlier acquisition.
+	setSurfaceDeviceOffset(surf, float64(-extents.Min.X), float64(-extents.Min.Y))
+	return surf
+}
+
+func (s *rasterSourceState) callAcquire(extents image.Rectangle) (surf *surface.ImageSurface, err error) {
+	defer recoverInto(&err)
+	return s.funcs.Acquire(extents)
+}
+
+// release hands a surface returned by acquire back to the Release callback,
+// or closes it once Cairo has released it as many times as it was acquired.
+func (s *rasterSourceState) release(key unsafe.Pointer) {
+	s.mu.Lock()
+	a, ok := s.acquired[key]
+	last := false
+	if ok {
+		a.n--
+		if last = a.n == 0; last {
+			delete(s.acquired, key)
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	if last {
+		setSurfaceDeviceOffset(a.surf, a.offsetX, a.offsetY)
+	}
+
+	switch {
+	case a.fallback || s.funcs.Release == nil:
+		if last {
+			_ = a.surf.Close()
+		}
+	default:
+		if err := s.callRelease(a.surf); err != nil {
+			s.fail(err)
+		}
+	}
+}
+
+func (s *rasterSourceState) callRelease(surf *surface.ImageSurface) (err error) {
+	defer recoverInto(&err)
+	s.funcs.Release(surf)
+	return nil
+}
+
+// snapshot calls the Snapshot callback, if any, and returns the status to
+// report to Cairo.
+func (s *rasterSourceState) snapshot() status.Status {
+	if s.funcs.Snapshot == nil {
+		return status.Success
+	}
+
+	err := s.callSnapshot()
+	if err == nil {
+		return status.Success
+	}
+	s.fail(err)
+
+	var st status.Status
+	if errors.As(err, &st) {
+		return st
+	}
+	return status.ReadError
+}
+
+func (s *rasterSourceState) callSnapshot() (err error) {
+	defer recoverInto(&err)
+	return s.funcs.Snapshot()
+}
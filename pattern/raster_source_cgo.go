@@ -0,0 +1,125 @@
+package pattern
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdint.h>
+//
+// extern cairo_surface_t *goRasterSourceAcquire(void *data, int x, int y, int width, int height);
+// extern void goRasterSourceRelease(void *data, cairo_surface_t *surface);
+// extern cairo_status_t goRasterSourceSnapshot(void *data);
+// extern void goRasterSourceRetain(void *data);
+// extern void goRasterSourceFinish(void *data);
+//
+// static cairo_surface_t *_rasterSourceAcquire(cairo_pattern_t *pattern, void *data,
+//                                              cairo_surface_t *target,
+//                                              const cairo_rectangle_int_t *extents) {
+//     if (extents == NULL) {
+//         return goRasterSourceAcquire(data, 0, 0, 0, 0);
+//     }
+//     return goRasterSourceAcquire(data, extents->x, extents->y, extents->width, extents->height);
+// }
+//
+// static void _rasterSourceRelease(cairo_pattern_t *pattern, void *data, cairo_surface_t *surface) {
+//     goRasterSourceRelease(data, surface);
+// }
+//
+// static cairo_status_t _rasterSourceSnapshot(cairo_pattern_t *pattern, void *data) {
+//     return goRasterSourceSnapshot(data);
+// }
+//
+// // Copies of a pattern share its callback data, and each copy is finished
+// // separately, so the Go state is reference counted.
+// static cairo_status_t _rasterSourceCopy(cairo_pattern_t *pattern, void *data,
+//                                         const cairo_pattern_t *other) {
+//     goRasterSourceRetain(data);
+//     return CAIRO_STATUS_SUCCESS;
+// }
+//
+// static void _rasterSourceFinish(cairo_pattern_t *pattern, void *data) {
+//     goRasterSourceFinish(data);
+// }
+//
+// // _goRasterSourceKey marks raster source patterns created by this package,
+// // whose callback data is a Go handle.
+// static cairo_user_data_key_t _goRasterSourceKey;
+//
+// static cairo_status_t _rasterSourceMark(cairo_pattern_t *p) {
+//     return cairo_pattern_set_user_data(p, &_goRasterSourceKey, &_goRasterSourceKey, NULL);
+// }
+//
+// static int _rasterSourceIsMarked(cairo_pattern_t *p) {
+//     return cairo_pattern_get_user_data(p, &_goRasterSourceKey) != NULL;
+// }
+//
+// static cairo_pattern_t *_rasterSourceCreate(uintptr_t h, cairo_content_t content, int width, int height) {
+//     cairo_pattern_t *p = cairo_pattern_create_raster_source((void *)h, content, width, height);
+//     if (cairo_pattern_status(p) != CAIRO_STATUS_SUCCESS) {
+//         return p;
+//     }
+//     cairo_raster_source_pattern_set_acquire(p, _rasterSourceAcquire, _rasterSourceRelease);
+//     cairo_raster_source_pattern_set_snapshot(p, _rasterSourceSnapshot);
+//     cairo_raster_source_pattern_set_copy(p, _rasterSourceCopy);
+//     cairo_raster_source_pattern_set_finish(p, _rasterSourceFinish);
+//     return p;
+// }
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+)
+
+// rasterSourceCreate creates a raster source pattern whose callbacks are
+// served by state. The handle to state is released by the finish callback
+// when Cairo destroys the last copy of the pattern.
+func rasterSourceCreate(state *rasterSourceState, content surface.Content, width, height int) (PatternPtr, status.Status) {
+	state.refs.Store(1)
+	h := cgo.NewHandle(state)
+
+	ptr := PatternPtr(C._rasterSourceCreate(C.uintptr_t(h), C.cairo_content_t(content), C.int(width), C.int(height)))
+	if st := patternStatus(ptr); st != status.Success {
+		patternClose(ptr)
+		h.Delete()
+		return nil, st
+	}
+
+	// From here on the finish callback owns the handle.
+	if st := status.Status(C._rasterSourceMark(ptr)); st != status.Success {
+		patternClose(ptr)
+		return nil, st
+	}
+	return ptr, status.Success
+}
+
+// rasterSourceGetState returns the Go state of a raster source pattern
+// created by rasterSourceCreate, or nil for raster sources created
+// elsewhere, such as by C code sharing a context, whose callback data is not
+// a Go handle.
+func rasterSourceGetState(ptr PatternPtr) *rasterSourceState {
+	if C._rasterSourceIsMarked(ptr) == 0 {
+		return nil
+	}
+	data := C.cairo_raster_source_pattern_get_callback_data(ptr)
+	if data == nil {
+		return nil
+	}
+	state, _ := cgo.Handle(uintptr(data)).Value().(*rasterSourceState)
+	return state
+}
+
+// surfaceDeviceOffset returns the device offset of s.
+func surfaceDeviceOffset(s *surface.ImageSurface) (x, y float64) {
+	var cx, cy C.double
+	ptr := (*C.cairo_surface_t)(unsafe.Pointer(s.Ptr())) //nolint:gosec
+	C.cairo_surface_get_device_offset(ptr, &cx, &cy)
+	return float64(cx), float64(cy)
+}
+
+// setSurfaceDeviceOffset sets the device offset of s.
+func setSurfaceDeviceOffset(s *surface.ImageSurface, x, y float64) {
+	ptr := (*C.cairo_surface_t)(unsafe.Pointer(s.Ptr())) //nolint:gosec
+	C.cairo_surface_set_device_offset(ptr, C.double(x), C.double(y))
+}
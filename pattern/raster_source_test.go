@@ -0,0 +1,200 @@
+// ABOUTME: Tests for RasterSourcePattern: construction, and acquire/release/snapshot bookkeeping
+// ABOUTME: including recovery of callback errors and panics into a pattern status.
+package pattern
+
+import (
+	"errors"
+	"image"
+	"testing"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRasterSource returns a 10x10 raster source serving fresh surfaces.
+func newTestRasterSource(t *testing.T, funcs RasterSourceFuncs) *RasterSourcePattern {
+	t.Helper()
+	if funcs.Acquire == nil {
+		funcs.Acquire = func(extents image.Rectangle) (*surface.ImageSurface, error) {
+			return surface.NewImageSurface(surface.FormatARGB32, extents.Dx(), extents.Dy())
+		}
+	}
+
+	p, err := NewRasterSourcePattern(surface.ContentColorAlpha, 10, 10, funcs)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = p.Close()
+	})
+	return p
+}
+
+// TestNewRasterSourcePattern tests creation of raster source patterns.
+func TestNewRasterSourcePattern(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		p := newTestRasterSource(t, RasterSourceFuncs{})
+		assert.Equal(t, status.Success, p.Status())
+		assert.Equal(t, PatternTypeRasterSource, p.GetType())
+		assert.NoError(t, p.Err())
+	})
+
+	t.Run("nil_acquire", func(t *testing.T) {
+		p, err := NewRasterSourcePattern(surface.ContentColorAlpha, 10, 10, RasterSourceFuncs{})
+		assert.Nil(t, p)
+		assert.Equal(t, status.NullPointer, err)
+	})
+
+	t.Run("negative_size", func(t *testing.T) {
+		p, err := NewRasterSourcePattern(surface.ContentColorAlpha, -1, 10, RasterSourceFuncs{
+			Acquire: func(image.Rectangle) (*surface.ImageSurface, error) { return nil, nil },
+		})
+		assert.Nil(t, p)
+		assert.Equal(t, status.InvalidSize, err)
+	})
+}
+
+// TestRasterSourceAcquireRelease verifies acquired surfaces are passed to
+// Release, or closed when Release is nil.
+func TestRasterSourceAcquireRelease(t *testing.T) {
+	t.Run("default_release_closes", func(t *testing.T) {
+		p := newTestRasterSource(t, RasterSourceFuncs{})
+
+		surf := p.state.acquire(image.Rect(0, 0, 10, 10))
+		require.NotNil(t, surf)
+		assert.Equal(t, 10, surf.GetWidth())
+
+		p.state.release(unsafe.Pointer(surf.Ptr())) //nolint:gosec
+		assert.Equal(t, status.NullPointer, surf.Status())
+		assert.NoError(t, p.Err())
+	})
+
+	t.Run("custom_release", func(t *testing.T) {
+		cached, err := surface.NewImageSurface(surface.FormatARGB32, 10, 10)
+		require.NoError(t, err)
+		defer func() {
+			_ = cached.Close()
+		}()
+
+		var released []*surface.ImageSurface
+		p := newTestRasterSource(t, RasterSourceFuncs{
+			Acquire: func(image.Rectangle) (*surface.ImageSurface, error) {
+				return cached, nil
+			},
+			Release: func(s *surface.ImageSurface) {
+				released = append(released, s)
+			},
+		})
+
+		require.Same(t, cached, p.state.acquire(image.Rect(0, 0, 10, 10)))
+		require.Same(t, cached, p.state.acquire(image.Rect(0, 0, 10, 10)))
+		p.state.release(unsafe.Pointer(cached.Ptr())) //nolint:gosec
+		p.state.release(unsafe.Pointer(cached.Ptr())) //nolint:gosec
+
+		assert.Equal(t, []*surface.ImageSurface{cached, cached}, released)
+		assert.Equal(t, status.Success, cached.Status(), "cached surface should not be closed")
+	})
+
+	t.Run("cached_device_offset", func(t *testing.T) {
+		cached, err := surface.NewImageSurface(surface.FormatARGB32, 10, 10)
+		require.NoError(t, err)
+		defer func() {
+			_ = cached.Close()
+		}()
+		setSurfaceDeviceOffset(cached, 3, 4)
+
+		p := newTestRasterSource(t, RasterSourceFuncs{
+			Acquire: func(image.Rectangle) (*surface.ImageSurface, error) {
+				return cached, nil
+			},
+			Release: func(*surface.ImageSurface) {},
+		})
+		offset := func() []float64 {
+			x, y := surfaceDeviceOffset(cached)
+			return []float64{x, y}
+		}
+		key := unsafe.Pointer(cached.Ptr()) //nolint:gosec
+
+		require.Same(t, cached, p.state.acquire(image.Rect(5, 5, 10, 10)))
+		assert.Equal(t, []float64{-5, -5}, offset())
+		require.Same(t, cached, p.state.acquire(image.Rect(0, 0, 10, 10)))
+		assert.Equal(t, []float64{0, 0}, offset(), "acquiring at the origin should reset the offset")
+
+		p.state.release(key)
+		p.state.release(key)
+		assert.Equal(t, []float64{3, 4}, offset(), "release should restore the caller's offset")
+
+		require.Same(t, cached, p.state.acquire(image.Rect(0, 0, 10, 10)))
+		assert.Equal(t, []float64{0, 0}, offset())
+		p.state.release(key)
+	})
+}
+
+// TestRasterSourceCallbackFailures verifies callback errors and panics are
+// recovered and reported through Status and Err.
+func TestRasterSourceCallbackFailures(t *testing.T) {
+	errDecode := errors.New("decode failed")
+
+	tests := []struct {
+		name    string
+		acquire func(image.Rectangle) (*surface.ImageSurface, error)
+		wantErr string
+	}{
+		{
+			name:    "error",
+			acquire: func(image.Rectangle) (*surface.ImageSurface, error) { return nil, errDecode },
+			wantErr: "decode failed",
+		},
+		{
+			name:    "panic",
+			acquire: func(image.Rectangle) (*surface.ImageSurface, error) { panic("tile missing") },
+			wantErr: "raster source callback panicked: tile missing",
+		},
+		{
+			name:    "nil_surface",
+			acquire: func(image.Rectangle) (*surface.ImageSurface, error) { return nil, nil },
+			wantErr: "raster source acquire returned a nil surface",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestRasterSource(t, RasterSourceFuncs{Acquire: tt.acquire})
+
+			surf := p.state.acquire(image.Rect(0, 0, 10, 10))
+			require.NotNil(t, surf, "a transparent fallback surface should be returned")
+			p.state.release(unsafe.Pointer(surf.Ptr())) //nolint:gosec
+			assert.Equal(t, status.NullPointer, surf.Status(), "the fallback surface should be closed")
+
+			assert.Equal(t, status.ReadError, p.Status())
+			require.Error(t, p.Err())
+			assert.Equal(t, tt.wantErr, p.Err().Error())
+		})
+	}
+}
+
+// TestRasterSourceSnapshot verifies the snapshot status returned to Cairo.
+func TestRasterSourceSnapshot(t *testing.T) {
+	p := newTestRasterSource(t, RasterSourceFuncs{})
+	assert.Equal(t, status.Success, p.state.snapshot(), "a nil Snapshot should succeed")
+
+	p = newTestRasterSource(t, RasterSourceFuncs{
+		Snapshot: func() error { return status.NoMemory },
+	})
+	assert.Equal(t, status.NoMemory, p.state.snapshot())
+	assert.Equal(t, status.ReadError, p.Status())
+
+	p = newTestRasterSource(t, RasterSourceFuncs{
+		Snapshot: func() error { panic("boom") },
+	})
+	assert.Equal(t, status.ReadError, p.state.snapshot())
+}
+
+// TestRasterSourceClosed verifies a closed raster source reports NullPointer.
+func TestRasterSourceClosed(t *testing.T) {
+	p := newTestRasterSource(t, RasterSourceFuncs{})
+	require.NoError(t, p.Close())
+
+	assert.Equal(t, status.NullPointer, p.Status())
+}
@@ -50,11 +50,11 @@ const (
 
 	// PatternTypeMesh represents a mesh gradient pattern.
 	// Complex gradients defined by a patch mesh with multiple control points.
-	// (Planned - not yet implemented)
+	// Created with NewMeshPattern.
 	PatternTypeMesh
 
 	// PatternTypeRasterSource represents a procedural pattern.
 	// Pattern content is generated programmatically on demand.
-	// (Planned - not yet implemented)
+	// Created with NewRasterSourcePattern.
 	PatternTypeRasterSource
 )
@@ -0,0 +1,31 @@
+// ABOUTME: Package region wraps cairo_region_t, a set of pixel-aligned rectangles with set operations.
+// ABOUTME: Regions are used for damage tracking and hit testing, and can drive BaseSurface.MarkDirtyRegion.
+
+// Package region provides [Region], an area of integer device space made up
+// of non-overlapping rectangles, as Cairo's cairo_region_t.
+//
+// Regions support the set operations union, intersection, difference and
+// symmetric difference with other regions and with single rectangles, point
+// and rectangle containment tests, translation and iteration over their
+// rectangles. Cairo keeps every region in a canonical form, so two regions
+// covering the same pixels are Equal however they were built.
+//
+// A common use is damage tracking: collect the areas that changed during a
+// frame, then mark them dirty on the surface before repainting:
+//
+//	damage, err := region.NewRegion()
+//	if err != nil {
+//	    return err
+//	}
+//	defer damage.Close()
+//
+//	for _, w := range changedWidgets {
+//	    damage.UnionRectangle(w.Bounds())
+//	}
+//	surf.MarkDirtyRegion(damage)
+//	for r := range damage.All() {
+//	    repaint(r)
+//	}
+//
+// Regions are also convenient for hit zones, using [Region.ContainsPoint].
+package region
@@ -0,0 +1,379 @@
+// ABOUTME: Defines Region, Rectangle and Overlap, wrapping cairo_region_t and cairo_rectangle_int_t.
+// ABOUTME: Provides construction, set operations, containment tests, translation, iteration and Equal.
+
+package region
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// Rectangle is a rectangle with integer coordinates, in device-space
+// pixels.
+type Rectangle struct {
+	X, Y, Width, Height int
+}
+
+// Overlap describes how a rectangle overlaps a region.
+//
+//go:generate stringer -type=Overlap
+type Overlap int
+
+// The iota values below must match Cairo's cairo_region_overlap_t C enum exactly.
+const (
+	// OverlapIn means the rectangle is entirely inside the region.
+	OverlapIn Overlap = iota
+
+	// OverlapOut means the rectangle is entirely outside the region.
+	OverlapOut
+
+	// OverlapPart means the rectangle is partly inside and partly outside
+	// the region.
+	OverlapPart
+)
+
+// Region is an area of integer device space, represented as a set of
+// non-overlapping rectangles.
+//
+// Region is safe for concurrent use. Methods on a closed Region have no
+// effect, operations return status.NullPointer and getters return zero
+// values.
+type Region struct {
+	sync.RWMutex
+	ptr RegionPtr
+}
+
+func newRegion(ptr RegionPtr) (*Region, error) {
+	if st := regionStatus(ptr); st != status.Success {
+		regionClose(ptr)
+		return nil, st
+	}
+	r := &Region{ptr: ptr}
+	runtime.SetFinalizer(r, (*Region).close)
+	return r, nil
+}
+
+// NewRegion creates an empty region.
+//
+// Returns status.NoMemory if the region cannot be allocated.
+func NewRegion() (*Region, error) {
+	return newRegion(regionCreate())
+}
+
+// NewRegionRectangle creates a region covering rect.
+//
+// Returns status.NoMemory if the region cannot be allocated.
+func NewRegionRectangle(rect Rectangle) (*Region, error) {
+	return newRegion(regionCreateRectangle(rect))
+}
+
+// NewRegionRectangles creates a region covering the union of rects. The
+// rectangles may overlap.
+//
+// Returns status.NoMemory if the region cannot be allocated.
+func NewRegionRectangles(rects []Rectangle) (*Region, error) {
+	return newRegion(regionCreateRectangles(rects))
+}
+
+// Ptr returns the underlying C cairo_region_t pointer.
+func (r *Region) Ptr() unsafe.Pointer {
+	r.RLock()
+	defer r.RUnlock()
+
+	return unsafe.Pointer(r.ptr) //nolint:gosec
+}
+
+// Close releases the region.
+func (r *Region) Close() error {
+	return r.close()
+}
+
+// Status returns the status of the region, or status.NullPointer if it has
+// been closed. A region whose status is not status.Success is empty.
+func (r *Region) Status() status.Status {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return status.NullPointer
+	}
+	return regionStatus(r.ptr)
+}
+
+// Copy returns a new, independent copy of the region.
+//
+// Returns status.NullPointer if the region has been closed.
+func (r *Region) Copy() (*Region, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return newRegion(regionCopy(r.ptr))
+}
+
+// Extents returns the smallest rectangle containing the region.
+func (r *Region) Extents() Rectangle {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return Rectangle{}
+	}
+	return regionGetExtents(r.ptr)
+}
+
+// NumRectangles returns the number of rectangles the region is made of.
+func (r *Region) NumRectangles() int {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return 0
+	}
+	return regionNumRectangles(r.ptr)
+}
+
+// Rectangle returns the nth rectangle of the region. Rectangles are sorted
+// top to bottom, then left to right.
+//
+// Returns status.InvalidIndex if n is out of range, or status.NullPointer
+// if the region has been closed.
+func (r *Region) Rectangle(n int) (Rectangle, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return Rectangle{}, status.NullPointer
+	}
+	if n < 0 || n >= regionNumRectangles(r.ptr) {
+		return Rectangle{}, status.InvalidIndex
+	}
+	return regionGetRectangle(r.ptr, n), nil
+}
+
+// Rectangles returns the rectangles of the region, sorted top to bottom,
+// then left to right.
+func (r *Region) Rectangles() []Rectangle {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return nil
+	}
+	n := regionNumRectangles(r.ptr)
+	rects := make([]Rectangle, n)
+	for i := range rects {
+		rects[i] = regionGetRectangle(r.ptr, i)
+	}
+	return rects
+}
+
+// All returns an iterator over the rectangles of the region, in the order of
+// Rectangles. The rectangles are copied when iteration starts, so the region
+// may be modified inside the loop.
+func (r *Region) All() iter.Seq[Rectangle] {
+	return func(yield func(Rectangle) bool) {
+		for _, rect := range r.Rectangles() {
+			if !yield(rect) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty reports whether the region covers no pixels. A closed region is
+// empty.
+func (r *Region) IsEmpty() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return true
+	}
+	return regionIsEmpty(r.ptr)
+}
+
+// ContainsPoint reports whether the pixel at (x, y) is in the region.
+func (r *Region) ContainsPoint(x, y int) bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return false
+	}
+	return regionContainsPoint(r.ptr, x, y)
+}
+
+// ContainsRectangle reports whether rect is inside, outside or partly
+// inside the region. A closed region contains nothing.
+func (r *Region) ContainsRectangle(rect Rectangle) Overlap {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return OverlapOut
+	}
+	return regionContainsRectangle(r.ptr, rect)
+}
+
+// Equal reports whether r and other cover the same pixels. Nil or closed
+// regions are equal to nothing.
+func (r *Region) Equal(other *Region) bool {
+	if other == nil {
+		return false
+	}
+	if other == r {
+		return r.Ptr() != nil
+	}
+	otherPtr := other.Ptr()
+	if otherPtr == nil {
+		return false
+	}
+
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.ptr == nil {
+		return false
+	}
+	return regionEqual(r.ptr, RegionPtr(otherPtr))
+}
+
+// Translate moves the region by dx, dy.
+func (r *Region) Translate(dx, dy int) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ptr == nil {
+		return
+	}
+	regionTranslate(r.ptr, dx, dy)
+}
+
+// Union sets r to the area covered by either r or other.
+//
+// Returns status.NullPointer if either region is nil or closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) Union(other *Region) error {
+	return r.combine(other, regionUnion)
+}
+
+// UnionRectangle sets r to the area covered by either r or rect.
+//
+// Returns status.NullPointer if the region has been closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) UnionRectangle(rect Rectangle) error {
+	return r.combineRectangle(rect, regionUnionRectangle)
+}
+
+// Intersect sets r to the area covered by both r and other.
+//
+// Returns status.NullPointer if either region is nil or closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) Intersect(other *Region) error {
+	return r.combine(other, regionIntersect)
+}
+
+// IntersectRectangle sets r to the area covered by both r and rect.
+//
+// Returns status.NullPointer if the region has been closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) IntersectRectangle(rect Rectangle) error {
+	return r.combineRectangle(rect, regionIntersectRectangle)
+}
+
+// Subtract removes the area covered by other from r.
+//
+// Returns status.NullPointer if either region is nil or closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) Subtract(other *Region) error {
+	return r.combine(other, regionSubtract)
+}
+
+// SubtractRectangle removes rect from r.
+//
+// Returns status.NullPointer if the region has been closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) SubtractRectangle(rect Rectangle) error {
+	return r.combineRectangle(rect, regionSubtractRectangle)
+}
+
+// Xor sets r to the area covered by exactly one of r and other.
+//
+// Returns status.NullPointer if either region is nil or closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) Xor(other *Region) error {
+	return r.combine(other, regionXor)
+}
+
+// XorRectangle sets r to the area covered by exactly one of r and rect.
+//
+// Returns status.NullPointer if the region has been closed, or
+// status.NoMemory if the result cannot be allocated.
+func (r *Region) XorRectangle(rect Rectangle) error {
+	return r.combineRectangle(rect, regionXorRectangle)
+}
+
+// combine applies op to r with other as its operand.
+func (r *Region) combine(other *Region, op func(dst, other RegionPtr) status.Status) error {
+	if other == nil {
+		return status.NullPointer
+	}
+
+	var otherPtr RegionPtr
+	if other != r {
+		// Reading other's pointer takes its lock, which must not be done
+		// while holding r's lock when other is r.
+		otherPtr = RegionPtr(other.Ptr())
+		if otherPtr == nil {
+			return status.NullPointer
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ptr == nil {
+		return status.NullPointer
+	}
+	if other == r {
+		otherPtr = r.ptr
+	}
+	if st := op(r.ptr, otherPtr); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+// combineRectangle applies op to r with rect as its operand.
+func (r *Region) combineRectangle(rect Rectangle, op func(dst RegionPtr, rect Rectangle) status.Status) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ptr == nil {
+		return status.NullPointer
+	}
+	if st := op(r.ptr, rect); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+func (r *Region) close() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ptr != nil {
+		regionClose(r.ptr)
+		runtime.SetFinalizer(r, nil)
+		r.ptr = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package region
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+type RegionPtr *C.cairo_region_t
+
+func rectangleToC(r Rectangle) C.cairo_rectangle_int_t {
+	return C.cairo_rectangle_int_t{
+		x:      C.int(r.X),
+		y:      C.int(r.Y),
+		width:  C.int(r.Width),
+		height: C.int(r.Height),
+	}
+}
+
+func rectangleFromC(r C.cairo_rectangle_int_t) Rectangle {
+	return Rectangle{
+		X:      int(r.x),
+		Y:      int(r.y),
+		Width:  int(r.width),
+		Height: int(r.height),
+	}
+}
+
+func regionCreate() RegionPtr {
+	return RegionPtr(C.cairo_region_create())
+}
+
+func regionCreateRectangle(rect Rectangle) RegionPtr {
+	cRect := rectangleToC(rect)
+	return RegionPtr(C.cairo_region_create_rectangle(&cRect))
+}
+
+func regionCreateRectangles(rects []Rectangle) RegionPtr {
+	if len(rects) == 0 {
+		return regionCreate()
+	}
+
+	cRects := (*C.cairo_rectangle_int_t)(C.malloc(C.size_t(len(rects)) * C.sizeof_cairo_rectangle_int_t))
+	defer C.free(unsafe.Pointer(cRects))
+
+	out := unsafe.Slice(cRects, len(rects))
+	for i, r := range rects {
+		out[i] = rectangleToC(r)
+	}
+	return RegionPtr(C.cairo_region_create_rectangles(cRects, C.int(len(rects))))
+}
+
+func regionCopy(ptr RegionPtr) RegionPtr {
+	return RegionPtr(C.cairo_region_copy(ptr))
+}
+
+func regionClose(ptr RegionPtr) {
+	C.cairo_region_destroy(ptr)
+}
+
+func regionStatus(ptr RegionPtr) status.Status {
+	return status.Status(C.cairo_region_status(ptr))
+}
+
+func regionGetExtents(ptr RegionPtr) Rectangle {
+	var cRect C.cairo_rectangle_int_t
+	C.cairo_region_get_extents(ptr, &cRect)
+	return rectangleFromC(cRect)
+}
+
+func regionNumRectangles(ptr RegionPtr) int {
+	return int(C.cairo_region_num_rectangles(ptr))
+}
+
+func regionGetRectangle(ptr RegionPtr, n int) Rectangle {
+	var cRect C.cairo_rectangle_int_t
+	C.cairo_region_get_rectangle(ptr, C.int(n), &cRect)
+	return rectangleFromC(cRect)
+}
+
+func regionIsEmpty(ptr RegionPtr) bool {
+	return C.cairo_region_is_empty(ptr) != 0
+}
+
+func regionContainsPoint(ptr RegionPtr, x, y int) bool {
+	return C.cairo_region_contains_point(ptr, C.int(x), C.int(y)) != 0
+}
+
+func regionContainsRectangle(ptr RegionPtr, rect Rectangle) Overlap {
+	cRect := rectangleToC(rect)
+	return Overlap(C.cairo_region_contains_rectangle(ptr, &cRect))
+}
+
+func regionEqual(ptr, other RegionPtr) bool {
+	return C.cairo_region_equal(ptr, other) != 0
+}
+
+func regionTranslate(ptr RegionPtr, dx, dy int) {
+	C.cairo_region_translate(ptr, C.int(dx), C.int(dy))
+}
+
+func regionUnion(dst, other RegionPtr) status.Status {
+	return status.Status(C.cairo_region_union(dst, other))
+}
+
+func regionUnionRectangle(dst RegionPtr, rect Rectangle) status.Status {
+	cRect := rectangleToC(rect)
+	return status.Status(C.cairo_region_union_rectangle(dst, &cRect))
+}
+
+func regionIntersect(dst, other RegionPtr) status.Status {
+	return status.Status(C.cairo_region_intersect(dst, other))
+}
+
+func regionIntersectRectangle(dst RegionPtr, rect Rectangle) status.Status {
+	cRect := rectangleToC(rect)
+	return status.Status(C.cairo_region_intersect_rectangle(dst, &cRect))
+}
+
+func regionSubtract(dst, other RegionPtr) status.Status {
+	return status.Status(C.cairo_region_subtract(dst, other))
+}
+
+func regionSubtractRectangle(dst RegionPtr, rect Rectangle) status.Status {
+	cRect := rectangleToC(rect)
+	return status.Status(C.cairo_region_subtract_rectangle(dst, &cRect))
+}
+
+func regionXor(dst, other RegionPtr) status.Status {
+	return status.Status(C.cairo_region_xor(dst, other))
+}
+
+func regionXorRectangle(dst RegionPtr, rect Rectangle) status.Status {
+	cRect := rectangleToC(rect)
+	return status.Status(C.cairo_region_xor_rectangle(dst, &cRect))
+}
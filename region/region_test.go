@@ -0,0 +1,206 @@
+// ABOUTME: Tests for Region: construction, set operations, containment, translation, iteration and Equal.
+// ABOUTME: Also covers closed regions, which must behave as empty and report status.NullPointer.
+
+package region
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegion(t *testing.T, rects ...Rectangle) *Region {
+	t.Helper()
+
+	r, err := NewRegionRectangles(rects)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+	return r
+}
+
+// TestNewRegion tests the region constructors.
+func TestNewRegion(t *testing.T) {
+	empty, err := NewRegion()
+	require.NoError(t, err)
+	defer func() {
+		_ = empty.Close()
+	}()
+	assert.Equal(t, status.Success, empty.Status())
+	assert.True(t, empty.IsEmpty())
+	assert.Zero(t, empty.NumRectangles())
+	assert.Empty(t, empty.Rectangles())
+
+	one, err := NewRegionRectangle(Rectangle{10, 20, 30, 40})
+	require.NoError(t, err)
+	defer func() {
+		_ = one.Close()
+	}()
+	assert.False(t, one.IsEmpty())
+	assert.Equal(t, []Rectangle{{10, 20, 30, 40}}, one.Rectangles())
+	assert.Equal(t, Rectangle{10, 20, 30, 40}, one.Extents())
+
+	// Overlapping and adjacent rectangles are merged.
+	many := newTestRegion(t, Rectangle{0, 0, 10, 10}, Rectangle{5, 0, 10, 10}, Rectangle{15, 0, 5, 10})
+	assert.Equal(t, []Rectangle{{0, 0, 20, 10}}, many.Rectangles())
+
+	none := newTestRegion(t)
+	assert.True(t, none.IsEmpty())
+}
+
+// TestRegionOperations tests the set operations with regions and rectangles.
+func TestRegionOperations(t *testing.T) {
+	square := Rectangle{0, 0, 20, 20}
+	hole := Rectangle{5, 5, 10, 10}
+	frame := []Rectangle{{0, 0, 20, 5}, {0, 5, 5, 10}, {15, 5, 5, 10}, {0, 15, 20, 5}}
+
+	tests := []struct {
+		name   string
+		region func(r, other *Region) error
+		rect   func(r *Region, rect Rectangle) error
+		want   []Rectangle
+	}{
+		{"union", (*Region).Union, (*Region).UnionRectangle, []Rectangle{square}},
+		{"intersect", (*Region).Intersect, (*Region).IntersectRectangle, []Rectangle{hole}},
+		{"subtract", (*Region).Subtract, (*Region).SubtractRectangle, frame},
+		{"xor", (*Region).Xor, (*Region).XorRectangle, frame},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRegion(t, square)
+			other := newTestRegion(t, hole)
+			require.NoError(t, tt.region(r, other))
+			assert.Equal(t, tt.want, r.Rectangles())
+			assert.Equal(t, []Rectangle{hole}, other.Rectangles(), "the operand is unchanged")
+
+			r = newTestRegion(t, square)
+			require.NoError(t, tt.rect(r, hole))
+			assert.Equal(t, tt.want, r.Rectangles())
+		})
+	}
+}
+
+// TestRegionSelfOperations tests operations with the region itself as the
+// operand.
+func TestRegionSelfOperations(t *testing.T) {
+	r := newTestRegion(t, Rectangle{0, 0, 10, 10})
+
+	require.NoError(t, r.Union(r))
+	assert.Equal(t, []Rectangle{{0, 0, 10, 10}}, r.Rectangles())
+	require.NoError(t, r.Intersect(r))
+	assert.Equal(t, []Rectangle{{0, 0, 10, 10}}, r.Rectangles())
+	require.NoError(t, r.Xor(r))
+	assert.True(t, r.IsEmpty())
+}
+
+// TestRegionContains tests point and rectangle containment.
+func TestRegionContains(t *testing.T) {
+	r := newTestRegion(t, Rectangle{0, 0, 10, 10}, Rectangle{20, 0, 10, 10})
+
+	assert.True(t, r.ContainsPoint(0, 0))
+	assert.True(t, r.ContainsPoint(9, 9))
+	assert.False(t, r.ContainsPoint(10, 5), "the right edge is exclusive")
+	assert.False(t, r.ContainsPoint(15, 5))
+	assert.True(t, r.ContainsPoint(25, 5))
+
+	assert.Equal(t, OverlapIn, r.ContainsRectangle(Rectangle{2, 2, 5, 5}))
+	assert.Equal(t, OverlapOut, r.ContainsRectangle(Rectangle{11, 0, 8, 10}))
+	assert.Equal(t, OverlapPart, r.ContainsRectangle(Rectangle{5, 5, 20, 2}))
+}
+
+// TestRegionTranslate tests moving a region.
+func TestRegionTranslate(t *testing.T) {
+	r := newTestRegion(t, Rectangle{0, 0, 10, 10})
+
+	r.Translate(5, -3)
+	assert.Equal(t, Rectangle{5, -3, 10, 10}, r.Extents())
+	assert.True(t, r.ContainsPoint(5, -3))
+	assert.False(t, r.ContainsPoint(0, 0))
+}
+
+// TestRegionIteration tests accessing the rectangles of a region.
+func TestRegionIteration(t *testing.T) {
+	r := newTestRegion(t, Rectangle{20, 20, 5, 5}, Rectangle{0, 0, 5, 5})
+	want := []Rectangle{{0, 0, 5, 5}, {20, 20, 5, 5}}
+
+	assert.Equal(t, 2, r.NumRectangles())
+	for i, w := range want {
+		got, err := r.Rectangle(i)
+		require.NoError(t, err)
+		assert.Equal(t, w, got)
+	}
+	_, err := r.Rectangle(2)
+	assert.Equal(t, status.InvalidIndex, err)
+	_, err = r.Rectangle(-1)
+	assert.Equal(t, status.InvalidIndex, err)
+
+	var got []Rectangle
+	for rect := range r.All() {
+		got = append(got, rect)
+		// Modifying the region while iterating is allowed.
+		require.NoError(t, r.SubtractRectangle(rect))
+	}
+	assert.Equal(t, want, got)
+	assert.True(t, r.IsEmpty())
+
+	for range r.All() {
+		t.Fatal("an empty region has no rectangles")
+	}
+}
+
+// TestRegionEqualAndCopy tests Equal and Copy.
+func TestRegionEqualAndCopy(t *testing.T) {
+	a := newTestRegion(t, Rectangle{0, 0, 10, 10})
+	b := newTestRegion(t, Rectangle{0, 0, 5, 10}, Rectangle{5, 0, 5, 10})
+	c := newTestRegion(t, Rectangle{0, 0, 10, 11})
+
+	assert.True(t, a.Equal(a))
+	assert.True(t, a.Equal(b), "regions covering the same pixels are equal")
+	assert.False(t, a.Equal(c))
+	assert.False(t, a.Equal(nil))
+
+	cp, err := a.Copy()
+	require.NoError(t, err)
+	defer func() {
+		_ = cp.Close()
+	}()
+	assert.True(t, cp.Equal(a))
+	cp.Translate(1, 0)
+	assert.False(t, cp.Equal(a), "the copy is independent")
+}
+
+// TestRegionClosed tests that closed regions are empty and reject
+// operations.
+func TestRegionClosed(t *testing.T) {
+	r, err := NewRegionRectangle(Rectangle{0, 0, 10, 10})
+	require.NoError(t, err)
+	other := newTestRegion(t, Rectangle{0, 0, 10, 10})
+
+	require.NoError(t, r.Close())
+	require.NoError(t, r.Close())
+
+	assert.Nil(t, r.Ptr())
+	assert.Equal(t, status.NullPointer, r.Status())
+	assert.True(t, r.IsEmpty())
+	assert.Nil(t, r.Rectangles())
+	assert.Zero(t, r.NumRectangles())
+	assert.Equal(t, Rectangle{}, r.Extents())
+	assert.False(t, r.ContainsPoint(0, 0))
+	assert.Equal(t, OverlapOut, r.ContainsRectangle(Rectangle{0, 0, 1, 1}))
+	assert.False(t, r.Equal(other))
+	assert.False(t, other.Equal(r))
+	r.Translate(1, 1)
+
+	_, err = r.Copy()
+	assert.Equal(t, status.NullPointer, err)
+	_, err = r.Rectangle(0)
+	assert.Equal(t, status.NullPointer, err)
+	assert.Equal(t, status.NullPointer, r.UnionRectangle(Rectangle{0, 0, 1, 1}))
+	assert.Equal(t, status.NullPointer, r.Union(other))
+	assert.Equal(t, status.NullPointer, other.Union(r))
+	assert.Equal(t, status.NullPointer, other.Union(nil))
+}
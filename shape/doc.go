@@ -0,0 +1,51 @@
+// ABOUTME: Package shape shapes text with HarfBuzz into glyph runs for Cairo's glyph drawing API.
+// ABOUTME: It is opt-in: build with -tags harfbuzz to compile it against the HarfBuzz library.
+
+// Package shape converts text into glyphs with HarfBuzz, so that scripts
+// whose glyphs depend on their neighbors are drawn correctly.
+//
+// Context.ShowText maps each character to one glyph. That is enough for
+// Latin text, but Arabic letters change form with their position in a word,
+// Devanagari reorders vowel signs and forms conjuncts, and emoji ZWJ
+// sequences combine several code points into one glyph. [Shape] runs
+// HarfBuzz over a FreeType scaled font and returns a [Run] of positioned
+// glyphs, per-glyph advances and clusters, which can be passed straight to
+// Context.ShowTextGlyphs:
+//
+//	face, err := font.NewFaceFromFile("fonts/NotoSansArabic-Regular.ttf", 0)
+//	if err != nil {
+//	    return err
+//	}
+//	defer face.Close()
+//	ctx.SetFontFace(face)
+//	ctx.SetFontSize(24)
+//
+//	sf, err := ctx.GetScaledFont()
+//	if err != nil {
+//	    return err
+//	}
+//	defer sf.Close()
+//
+//	run, err := shape.Shape(sf, 20, 50, "مرحبا بالعالم", shape.Options{})
+//	if err != nil {
+//	    return err
+//	}
+//	err = ctx.ShowTextGlyphs(run.Text, run.Glyphs, run.Clusters, run.ClusterFlags)
+//
+// Because the run carries clusters, PDF output keeps the original text
+// searchable and copyable.
+//
+// # Build Tags
+//
+// Shaping requires HarfBuzz (harfbuzz pkg-config entry) and Cairo's
+// FreeType backend. HarfBuzz is not a dependency of Cairo, so the package
+// is only compiled with -tags harfbuzz, and not with -tags noft:
+//
+//	go build -tags harfbuzz ./...
+//
+// # Scope
+//
+// Shape shapes a single run of text in one direction and script. Splitting
+// mixed-direction text with the Unicode bidirectional algorithm, font
+// fallback and line breaking are left to the caller.
+package shape
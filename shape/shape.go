@@ -0,0 +1,223 @@
+// ABOUTME: Defines Shape, Options and Run, which turn UTF-8 text into positioned glyphs with HarfBuzz.
+// ABOUTME: Converts HarfBuzz positions into user space and HarfBuzz clusters into Cairo text clusters.
+
+//go:build harfbuzz && !noft
+
+package shape
+
+import (
+	"unicode/utf8"
+
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+)
+
+// Direction is the direction in which text is shaped.
+//
+//go:generate stringer -type=Direction
+type Direction int
+
+// The values below must match HarfBuzz's hb_direction_t C enum exactly.
+const (
+	// DirectionAuto guesses the direction from the script of the text.
+	DirectionAuto Direction = 0
+
+	// DirectionLTR shapes text left to right.
+	DirectionLTR Direction = 4
+
+	// DirectionRTL shapes text right to left, as for Arabic and Hebrew.
+	DirectionRTL Direction = 5
+
+	// DirectionTTB shapes text top to bottom.
+	DirectionTTB Direction = 6
+
+	// DirectionBTT shapes text bottom to top.
+	DirectionBTT Direction = 7
+)
+
+// backward reports whether glyphs in direction d are in the reverse of
+// their logical order.
+func (d Direction) backward() bool {
+	return d == DirectionRTL || d == DirectionBTT
+}
+
+// Options controls how text is shaped. The zero value guesses the direction,
+// script and language from the text.
+type Options struct {
+	// Direction is the direction of the text.
+	Direction Direction
+
+	// Script is the ISO 15924 script tag of the text, such as "Arab",
+	// "Deva" or "Latn". If it is empty, it is guessed from the text.
+	Script string
+
+	// Language is the BCP 47 language tag of the text, such as "ar" or
+	// "hi". Some fonts use it to pick localized glyph forms. If it is
+	// empty, the language of the process locale is used.
+	Language string
+
+	// Features turns OpenType features on or off, in HarfBuzz's feature
+	// syntax, such as "-liga" to disable ligatures or "smcp" to enable
+	// small capitals.
+	Features []string
+}
+
+// GlyphPosition is the placement of a single shaped glyph, in user-space
+// units.
+type GlyphPosition struct {
+	// Cluster is the byte offset in the text of the cluster the glyph
+	// belongs to.
+	Cluster int
+
+	// XAdvance and YAdvance move the pen to the next glyph.
+	XAdvance, YAdvance float64
+
+	// XOffset and YOffset move the glyph from the pen position without
+	// moving the pen, as for combining marks.
+	XOffset, YOffset float64
+}
+
+// Run is text shaped into glyphs.
+type Run struct {
+	// Text is the shaped text.
+	Text string
+
+	// Glyphs holds the glyphs in visual order, positioned in user space.
+	Glyphs []font.Glyph
+
+	// Positions holds the placement of each glyph, in the same order as
+	// Glyphs.
+	Positions []GlyphPosition
+
+	// Clusters maps the glyphs back to Text, as described by ClusterFlags.
+	Clusters []font.TextCluster
+
+	// ClusterFlags is font.TextClusterFlagBackward for right-to-left and
+	// bottom-to-top text, whose glyphs are in the reverse of the text's
+	// order.
+	ClusterFlags font.TextClusterFlags
+
+	// Direction is the direction the text was shaped in, resolved if
+	// Options.Direction was DirectionAuto.
+	Direction Direction
+
+	// XAdvance and YAdvance are the total advance of the run.
+	XAdvance, YAdvance float64
+}
+
+// Shape shapes text with sf, which must be a FreeType scaled font such as
+// one created from a face loaded with font.NewFaceFromFile, and positions
+// the first glyph's origin at (x, y) in user space.
+//
+// Shaping uses the font's own metrics, without hinting, so advances can
+// differ by a fraction of a pixel from those of Context.TextExtents.
+//
+// Returns status.InvalidString if text is not valid UTF-8 or a feature
+// cannot be parsed, status.FontTypeMismatch if sf is not a FreeType font,
+// or status.NullPointer if sf has been closed.
+func Shape(sf *font.ScaledFont, x, y float64, text string, opts Options) (*Run, error) {
+	if !utf8.ValidString(text) {
+		return nil, status.InvalidString
+	}
+	if st := sf.Status(); st != status.Success {
+		return nil, st
+	}
+	if sf.GetType() != font.FontTypeFT {
+		return nil, status.FontTypeMismatch
+	}
+
+	fm, err := sf.GetFontMatrix()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fm.Close()
+	}()
+
+	shaped, err := hbShape(sf, text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &Run{
+		Text:      text,
+		Glyphs:    make([]font.Glyph, len(shaped.glyphs)),
+		Positions: make([]GlyphPosition, len(shaped.glyphs)),
+		Direction: shaped.direction,
+	}
+
+	// HarfBuzz positions are in font units with y pointing up. Scaling by
+	// the units per em gives font space, which the font matrix maps to
+	// user space.
+	scale := 1 / float64(shaped.upem)
+	userDistance := func(dx, dy int32) (float64, float64) {
+		return fm.TransformDistance(float64(dx)*scale, -float64(dy)*scale)
+	}
+
+	penX, penY := x, y
+	for i, g := range shaped.glyphs {
+		xAdv, yAdv := userDistance(g.xAdvance, g.yAdvance)
+		xOff, yOff := userDistance(g.xOffset, g.yOffset)
+		run.Glyphs[i] = font.Glyph{Index: uint64(g.index), X: penX + xOff, Y: penY + yOff}
+		run.Positions[i] = GlyphPosition{
+			Cluster:  int(g.cluster),
+			XAdvance: xAdv,
+			YAdvance: yAdv,
+			XOffset:  xOff,
+			YOffset:  yOff,
+		}
+		penX += xAdv
+		penY += yAdv
+	}
+	run.XAdvance, run.YAdvance = penX-x, penY-y
+
+	run.Clusters, run.ClusterFlags = textClusters(len(text), run.Positions, run.Direction.backward())
+	return run, nil
+}
+
+// textClusters converts HarfBuzz cluster values, which are the byte offsets
+// of each glyph's cluster, into Cairo text clusters for text of textLen
+// bytes. If the clusters cannot be expressed as Cairo clusters, all of the
+// text is mapped to all of the glyphs.
+func textClusters(textLen int, positions []GlyphPosition, backward bool) ([]font.TextCluster, font.TextClusterFlags) {
+	flags := font.TextClusterFlagsNone
+	if backward {
+		flags = font.TextClusterFlagBackward
+	}
+	if len(positions) == 0 {
+		if textLen == 0 {
+			return nil, flags
+		}
+		return []font.TextCluster{{NumBytes: textLen}}, flags
+	}
+
+	// Visit the glyphs in logical order.
+	starts := make([]int, len(positions))
+	for i, p := range positions {
+		j := i
+		if backward {
+			j = len(positions) - 1 - i
+		}
+		starts[j] = p.Cluster
+	}
+
+	var clusters []font.TextCluster
+	valid := starts[0] == 0
+	for i := 0; i < len(starts) && valid; {
+		n := 1
+		for i+n < len(starts) && starts[i+n] == starts[i] {
+			n++
+		}
+		end := textLen
+		if i+n < len(starts) {
+			end = starts[i+n]
+		}
+		valid = end > starts[i]
+		clusters = append(clusters, font.TextCluster{NumBytes: end - starts[i], NumGlyphs: n})
+		i += n
+	}
+	if !valid {
+		return []font.TextCluster{{NumBytes: textLen, NumGlyphs: len(positions)}}, flags
+	}
+	return clusters, flags
+}
@@ -0,0 +1,182 @@
+// ABOUTME: CGO bindings that shape text with HarfBuzz using the FreeType face of a Cairo scaled font.
+// ABOUTME: Holds Cairo's face lock for the duration of shaping so HarfBuzz can read the font tables safely.
+
+//go:build harfbuzz && !noft
+
+package shape
+
+// #cgo pkg-config: harfbuzz cairo-ft freetype2
+// #include <cairo-ft.h>
+// #include <hb.h>
+// #include <hb-ft.h>
+// #include <hb-ot.h>
+// #include <stdlib.h>
+//
+// typedef struct {
+//     unsigned int index;
+//     unsigned int cluster;
+//     int x_advance, y_advance;
+//     int x_offset, y_offset;
+// } _shapeGlyph;
+//
+// // _shape shapes utf8 with the FreeType face of sf at a scale of one unit
+// // per font unit. On success *glyphs holds *num_glyphs glyphs allocated
+// // with malloc, which the caller frees.
+// static cairo_status_t _shape(cairo_scaled_font_t *sf, const char *utf8, int utf8_len,
+//                              hb_direction_t direction, const char *script, const char *language,
+//                              const hb_feature_t *features, unsigned int num_features,
+//                              _shapeGlyph **glyphs, unsigned int *num_glyphs,
+//                              hb_direction_t *out_direction, unsigned int *upem) {
+//     FT_Face ft = cairo_ft_scaled_font_lock_face(sf);
+//     if (ft == NULL) {
+//         return CAIRO_STATUS_FONT_TYPE_MISMATCH;
+//     }
+//
+//     // The face reads tables through FreeType without referencing the
+//     // FT_Face, so it must not outlive the lock.
+//     hb_face_t *face = hb_ft_face_create(ft, NULL);
+//     hb_font_t *font = hb_font_create(face);
+//     hb_ot_font_set_funcs(font);
+//     *upem = hb_face_get_upem(face);
+//     hb_font_set_scale(font, (int)*upem, (int)*upem);
+//
+//     hb_buffer_t *buf = hb_buffer_create();
+//     hb_buffer_add_utf8(buf, utf8, utf8_len, 0, utf8_len);
+//     if (direction != HB_DIRECTION_INVALID) {
+//         hb_buffer_set_direction(buf, direction);
+//     }
+//     if (script != NULL) {
+//         hb_buffer_set_script(buf, hb_script_from_string(script, -1));
+//     }
+//     if (language != NULL) {
+//         hb_buffer_set_language(buf, hb_language_from_string(language, -1));
+//     }
+//     hb_buffer_guess_segment_properties(buf);
+//     hb_shape(font, buf, features, num_features);
+//
+//     cairo_status_t st = CAIRO_STATUS_SUCCESS;
+//     unsigned int n = 0;
+//     *glyphs = NULL;
+//     if (!hb_buffer_allocation_successful(buf)) {
+//         st = CAIRO_STATUS_NO_MEMORY;
+//     } else {
+//         hb_glyph_info_t *info = hb_buffer_get_glyph_infos(buf, &n);
+//         hb_glyph_position_t *pos = hb_buffer_get_glyph_positions(buf, NULL);
+//         if (n > 0) {
+//             *glyphs = malloc(n * sizeof(_shapeGlyph));
+//             if (*glyphs == NULL) {
+//                 st = CAIRO_STATUS_NO_MEMORY;
+//                 n = 0;
+//             }
+//         }
+//         for (unsigned int i = 0; *glyphs != NULL && i < n; i++) {
+//             (*glyphs)[i].index = info[i].codepoint;
+//             (*glyphs)[i].cluster = info[i].cluster;
+//             (*glyphs)[i].x_advance = pos[i].x_advance;
+//             (*glyphs)[i].y_advance = pos[i].y_advance;
+//             (*glyphs)[i].x_offset = pos[i].x_offset;
+//             (*glyphs)[i].y_offset = pos[i].y_offset;
+//         }
+//     }
+//     *num_glyphs = n;
+//     *out_direction = hb_buffer_get_direction(buf);
+//
+//     hb_buffer_destroy(buf);
+//     hb_font_destroy(font);
+//     hb_face_destroy(face);
+//     cairo_ft_scaled_font_unlock_face(sf);
+//     return st;
+// }
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/status"
+)
+
+// shapedGlyph is a glyph as shaped by HarfBuzz, in font units.
+type shapedGlyph struct {
+	index              uint32
+	cluster            uint32
+	xAdvance, yAdvance int32
+	xOffset, yOffset   int32
+}
+
+// shaped is the result of shaping text with HarfBuzz.
+type shaped struct {
+	glyphs    []shapedGlyph
+	direction Direction
+	upem      uint32
+}
+
+func hbShape(sf *font.ScaledFont, text string, opts Options) (*shaped, error) {
+	sfPtr := sf.Ptr()
+	if sfPtr == nil {
+		return nil, status.NullPointer
+	}
+
+	features := make([]C.hb_feature_t, len(opts.Features))
+	for i, f := range opts.Features {
+		cf := C.CString(f)
+		ok := C.hb_feature_from_string(cf, -1, &features[i])
+		C.free(unsafe.Pointer(cf))
+		if ok == 0 {
+			return nil, status.InvalidString
+		}
+	}
+	var featuresPtr *C.hb_feature_t
+	if len(features) > 0 {
+		featuresPtr = &features[0]
+	}
+
+	var script, language *C.char
+	if opts.Script != "" {
+		script = C.CString(opts.Script)
+		defer C.free(unsafe.Pointer(script))
+	}
+	if opts.Language != "" {
+		language = C.CString(opts.Language)
+		defer C.free(unsafe.Pointer(language))
+	}
+
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var cGlyphs *C._shapeGlyph
+	var numGlyphs, upem C.uint
+	var direction C.hb_direction_t
+	st := status.Status(C._shape(
+		(*C.cairo_scaled_font_t)(sfPtr),
+		cText, C.int(len(text)),
+		C.hb_direction_t(opts.Direction), script, language,
+		featuresPtr, C.uint(len(features)),
+		&cGlyphs, &numGlyphs,
+		&direction, &upem,
+	))
+	if st != status.Success {
+		return nil, st
+	}
+	defer C.free(unsafe.Pointer(cGlyphs))
+
+	result := &shaped{
+		glyphs:    make([]shapedGlyph, int(numGlyphs)),
+		direction: Direction(direction),
+		upem:      uint32(upem),
+	}
+	if numGlyphs == 0 {
+		return result, nil
+	}
+	for i, g := range unsafe.Slice(cGlyphs, int(numGlyphs)) {
+		result.glyphs[i] = shapedGlyph{
+			index:    uint32(g.index),
+			cluster:  uint32(g.cluster),
+			xAdvance: int32(g.x_advance),
+			yAdvance: int32(g.y_advance),
+			xOffset:  int32(g.x_offset),
+			yOffset:  int32(g.y_offset),
+		}
+	}
+	return result, nil
+}
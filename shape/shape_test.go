@@ -0,0 +1,188 @@
+// ABOUTME: Tests for shaping text with HarfBuzz and converting its clusters for Cairo.
+// ABOUTME: Uses the font committed under internal/testfont, so shaping results do not depend on the host.
+
+//go:build harfbuzz && !noft
+
+package shape
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/context"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/font/userfont"
+	"github.com/mikowitz/cairo/internal/testfont"
+	"github.com/mikowitz/cairo/matrix"
+	"github.com/mikowitz/cairo/status"
+	"github.com/mikowitz/cairo/surface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestScaledFont returns a 20-unit scaled font loaded from the test font.
+func newTestScaledFont(t *testing.T) *font.ScaledFont {
+	t.Helper()
+
+	face, err := font.NewFaceFromFile(testfont.Path(), 0)
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+
+	sf, err := font.NewScaledFont(face, matrix.NewScalingMatrix(20, 20), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sf.Close()
+	})
+	return sf
+}
+
+// glyphIndices returns the glyph indices of run.
+func glyphIndices(run *Run) []uint64 {
+	indices := make([]uint64, len(run.Glyphs))
+	for i, g := range run.Glyphs {
+		indices[i] = g.Index
+	}
+	return indices
+}
+
+// TestShapeLTR tests shaping left-to-right text.
+func TestShapeLTR(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	run, err := Shape(sf, 10, 30, "abc", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, DirectionLTR, run.Direction)
+	assert.Equal(t, font.TextClusterFlagsNone, run.ClusterFlags)
+	require.Len(t, run.Glyphs, 3)
+	require.NoError(t, font.ValidateTextClusters(run.Text, len(run.Glyphs), run.Clusters))
+
+	// Plain Latin text maps one character to one glyph, as Cairo does.
+	plain, err := sf.TextToGlyphs(10, 30, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{plain[0].Index, plain[1].Index, plain[2].Index}, glyphIndices(run))
+
+	assert.Equal(t, 10.0, run.Glyphs[0].X)
+	assert.Equal(t, 30.0, run.Glyphs[0].Y)
+	for i := 1; i < len(run.Glyphs); i++ {
+		assert.InDelta(t, run.Glyphs[i-1].X+run.Positions[i-1].XAdvance, run.Glyphs[i].X, 1e-9)
+		assert.Equal(t, i, run.Positions[i].Cluster)
+	}
+	assert.InDelta(t, sf.TextExtents("abc").XAdvance, run.XAdvance, 1.0, "unhinted advances are close to Cairo's")
+	assert.Zero(t, run.YAdvance)
+}
+
+// TestShapeRTL tests that right-to-left glyphs are in visual order with
+// backward clusters.
+func TestShapeRTL(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	ltr, err := Shape(sf, 0, 0, "abc", Options{Direction: DirectionLTR})
+	require.NoError(t, err)
+	rtl, err := Shape(sf, 0, 0, "abc", Options{Direction: DirectionRTL})
+	require.NoError(t, err)
+
+	assert.Equal(t, DirectionRTL, rtl.Direction)
+	assert.Equal(t, font.TextClusterFlagBackward, rtl.ClusterFlags)
+	require.NoError(t, font.ValidateTextClusters(rtl.Text, len(rtl.Glyphs), rtl.Clusters))
+
+	want := glyphIndices(ltr)
+	for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+		want[i], want[j] = want[j], want[i]
+	}
+	assert.Equal(t, want, glyphIndices(rtl))
+	assert.Equal(t, 2, rtl.Positions[0].Cluster)
+	assert.Greater(t, rtl.Glyphs[1].X, rtl.Glyphs[0].X, "glyphs are positioned left to right")
+}
+
+// TestShapeDraw tests that a run feeds into Context.ShowTextGlyphs.
+func TestShapeDraw(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	surf, err := surface.NewImageSurface(surface.FormatARGB32, 100, 50)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	ctx, err := context.NewContext(surf)
+	require.NoError(t, err)
+	defer func() {
+		_ = ctx.Close()
+	}()
+	ctx.SetScaledFont(sf)
+
+	run, err := Shape(sf, 10, 30, "Shape", Options{Features: []string{"-liga", "kern"}})
+	require.NoError(t, err)
+	require.NoError(t, ctx.ShowTextGlyphs(run.Text, run.Glyphs, run.Clusters, run.ClusterFlags))
+	assert.Equal(t, status.Success, ctx.Status())
+
+	empty, err := Shape(sf, 0, 0, "", Options{})
+	require.NoError(t, err)
+	assert.Empty(t, empty.Glyphs)
+	require.NoError(t, ctx.ShowTextGlyphs(empty.Text, empty.Glyphs, empty.Clusters, empty.ClusterFlags))
+}
+
+// TestShapeErrors tests rejected text, options and fonts.
+func TestShapeErrors(t *testing.T) {
+	sf := newTestScaledFont(t)
+
+	_, err := Shape(sf, 0, 0, "a\xff", Options{})
+	assert.Equal(t, status.InvalidString, err)
+
+	_, err = Shape(sf, 0, 0, "abc", Options{Features: []string{"!!"}})
+	assert.Equal(t, status.InvalidString, err)
+
+	face, err := userfont.NewFace(userfont.Funcs{
+		RenderGlyph: func(*font.ScaledFont, uint64, *context.Context, *font.TextExtents) error { return nil },
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = face.Close()
+	}()
+	user, err := font.NewScaledFont(face, matrix.NewScalingMatrix(20, 20), matrix.NewIdentityMatrix())
+	require.NoError(t, err)
+	defer func() {
+		_ = user.Close()
+	}()
+	_, err = Shape(user, 0, 0, "abc", Options{})
+	assert.Equal(t, status.FontTypeMismatch, err)
+
+	require.NoError(t, sf.Close())
+	_, err = Shape(sf, 0, 0, "abc", Options{})
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestTextClusters tests converting HarfBuzz clusters into Cairo clusters.
+func TestTextClusters(t *testing.T) {
+	positions := func(clusters ...int) []GlyphPosition {
+		p := make([]GlyphPosition, len(clusters))
+		for i, c := range clusters {
+			p[i].Cluster = c
+		}
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		textLen   int
+		positions []GlyphPosition
+		backward  bool
+		want      []font.TextCluster
+	}{
+		{"empty", 0, nil, false, nil},
+		{"one_per_character", 3, positions(0, 1, 2), false, []font.TextCluster{{1, 1}, {1, 1}, {1, 1}}},
+		{"ligature", 3, positions(0), false, []font.TextCluster{{3, 1}}},
+		{"combining_mark", 4, positions(0, 0, 3), false, []font.TextCluster{{3, 2}, {1, 1}}},
+		{"backward", 5, positions(3, 1, 0), true, []font.TextCluster{{1, 1}, {2, 1}, {2, 1}}},
+		{"out_of_order", 2, positions(1, 0), false, []font.TextCluster{{2, 2}}},
+		{"no_glyphs", 2, nil, false, []font.TextCluster{{2, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters, flags := textClusters(tt.textLen, tt.positions, tt.backward)
+			assert.Equal(t, tt.want, clusters)
+			assert.Equal(t, tt.backward, flags == font.TextClusterFlagBackward)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package surface
+
+// Content describes whether a surface holds color information, alpha
+// information, or both. These values correspond directly to Cairo's
+// cairo_content_t enum.
+//
+//go:generate stringer -type=Content -trimprefix=Content
+type Content int
+
+const (
+	// ContentColor means the surface holds color information only.
+	ContentColor Content = 0x1000
+	// ContentAlpha means the surface holds alpha (transparency) information only.
+	ContentAlpha Content = 0x2000
+	// ContentColorAlpha means the surface holds both color and alpha information.
+	ContentColorAlpha Content = 0x3000
+)
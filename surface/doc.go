@@ -31,7 +31,20 @@ written to PNG files. Use ImageSurface when you need:
 Example:
   surf, err := surface.NewImageSurface(surface.FormatARGB32, 640, 480)
 
-PDFSurface - Vector output to PDF files (future)
+The pixel buffer is available without copying through GetData, and Image
+returns a draw.Image view of it that works with Go's image encoders:
+
+  img, err := surf.Image()
+  if err != nil {
+      return err
+  }
+  err = png.Encode(w, img)
+
+To draw into memory you already own, NewImageSurfaceForData wraps a caller
+buffer without copying, and NewImageSurfaceFromImage copies any image.Image
+into a new ARGB32 surface.
+
+PDFSurface - Vector output to PDF files
 
 PDFSurface writes vector graphics directly to PDF files, preserving scalability.
 Text and graphics remain resolution-independent. Use PDFSurface when you need:
@@ -40,7 +53,7 @@ Text and graphics remain resolution-independent. Use PDFSurface when you need:
   - Print-quality output
   - Small file sizes for simple graphics
 
-SVGSurface - Vector output to SVG files (future)
+SVGSurface - Vector output to SVG files
 
 SVGSurface generates Scalable Vector Graphics files suitable for web use and
 vector editing tools. Use SVGSurface when you need:
@@ -49,7 +62,15 @@ vector editing tools. Use SVGSurface when you need:
   - CSS styling integration
   - Embedding in HTML
 
-RecordingSurface - Capture and replay drawing operations (future)
+Both vector surfaces can also write to any io.Writer instead of a file, with
+NewPDFSurfaceForWriter and NewSVGSurfaceForWriter. The document is completed
+when the surface is closed, and Close reports any error from the writer.
+Raster surfaces can likewise be encoded to an io.Writer with WriteToPNGWriter:
+
+  var buf bytes.Buffer
+  err := surf.WriteToPNGWriter(&buf)
+
+RecordingSurface - Capture and replay drawing operations
 
 RecordingSurface records all drawing operations for later playback to other
 surfaces. Use RecordingSurface when you need:
@@ -58,6 +79,14 @@ surfaces. Use RecordingSurface when you need:
   - Drawing operation analysis
   - Deferred rendering
 
+A recording can be replayed onto any surface with Replay, or used as the
+source of a surface pattern:
+
+  rec, err := surface.NewUnboundedRecordingSurface(surface.ContentColorAlpha)
+  // ... draw the chart once ...
+  err = rec.Replay(pngSurface)
+  err = rec.Replay(pdfSurface)
+
 # Resource Management
 
 All surfaces implement the Surface interface and must be properly closed to
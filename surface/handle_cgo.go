@@ -0,0 +1,79 @@
+// ABOUTME: Exported callbacks that Cairo invokes for stream I/O and to release Go state attached to a surface.
+// ABOUTME: goSurfaceReadStream feeds PNG loading; goSurfaceWriteStream serves PNG and *_for_stream output.
+
+package surface
+
+// #include <cairo.h>
+// #include <stdint.h>
+import "C"
+
+import (
+	"io"
+	"runtime"
+	"runtime/cgo"
+	"unsafe"
+)
+
+// releaser is implemented by Go values attached to a C surface that need
+// explicit cleanup when Cairo destroys the surface.
+type releaser interface {
+	release()
+}
+
+// pinnedData keeps Go-owned pixel memory pinned for as long as Cairo may
+// read or write it, as required by the cgo pointer passing rules.
+type pinnedData struct {
+	pinner runtime.Pinner
+}
+
+func (p *pinnedData) release() {
+	p.pinner.Unpin()
+}
+
+// releaseHandle releases the value held by h, if it needs it, and deletes h.
+func releaseHandle(h cgo.Handle) {
+	if r, ok := h.Value().(releaser); ok {
+		r.release()
+	}
+	h.Delete()
+}
+
+//export goSurfaceReleaseHandle
+func goSurfaceReleaseHandle(data unsafe.Pointer) {
+	releaseHandle(cgo.Handle(uintptr(data)))
+}
+
+// goSurfaceReadStream fills data from the io.Reader held by closure. A panic
+// in the reader is reported to Cairo as a read error rather than unwinding
+// through Cairo's C frames.
+//
+//export goSurfaceReadStream
+func goSurfaceReadStream(closure unsafe.Pointer, data *C.uchar, length C.uint) (st C.cairo_status_t) {
+	defer func() {
+		if recover() != nil {
+			st = C.CAIRO_STATUS_READ_ERROR
+		}
+	}()
+
+	r, ok := cgo.Handle(uintptr(closure)).Value().(io.Reader)
+	if !ok {
+		return C.CAIRO_STATUS_READ_ERROR
+	}
+	buf := unsafe.Slice((*byte)(unsafe.Pointer(data)), int(length))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return C.CAIRO_STATUS_READ_ERROR
+	}
+	return C.CAIRO_STATUS_SUCCESS
+}
+
+// goSurfaceWriteStream forwards data to the streamWriter held by closure,
+// which recovers panics in the underlying io.Writer.
+//
+//export goSurfaceWriteStream
+func goSurfaceWriteStream(closure unsafe.Pointer, data *C.uchar, length C.uint) C.cairo_status_t {
+	w, ok := cgo.Handle(uintptr(closure)).Value().(*streamWriter)
+	if !ok || !w.write(unsafe.Slice((*byte)(unsafe.Pointer(data)), int(length))) {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+	return C.CAIRO_STATUS_SUCCESS
+}
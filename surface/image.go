@@ -0,0 +1,244 @@
+// ABOUTME: Adapts an ImageSurface's pixel buffer to Go's image.Image and draw.Image interfaces.
+// ABOUTME: Decodes and encodes every Cairo pixel format directly in the surface's memory.
+
+package surface
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// Image exposes the pixels of an [ImageSurface] as a Go [draw.Image].
+//
+// Image reads and writes Cairo's pixel buffer in place, so it can be handed
+// directly to encoders such as image/png or used as the destination of
+// [draw.Draw]. The color model depends on the surface format:
+//
+//   - FormatARGB32: [color.RGBAModel] (premultiplied alpha, as Cairo stores it)
+//   - FormatRGB24: an opaque RGBA model; alpha is always 0xff
+//   - FormatA8: [color.AlphaModel]
+//   - FormatA1: an alpha model with two levels, thresholded at 50%
+//   - FormatRGB16_565: an opaque RGBA model with 5/6/5 bits per channel
+//   - FormatRGB30: an opaque RGBA64 model with 10 bits per channel
+//
+// An Image is only valid while its surface is open. After changing pixels
+// through Set, call MarkDirty on the surface before drawing on it with Cairo
+// again. After drawing with Cairo, create a new Image (or call Flush on the
+// surface) before reading pixels.
+type Image struct {
+	data          []byte
+	stride        int
+	format        Format
+	width, height int
+}
+
+var _ draw.Image = (*Image)(nil)
+
+// Image returns a [draw.Image] view of the surface's pixel buffer.
+//
+// The surface is flushed first, so the returned image reflects all drawing
+// done so far. See [ImageSurface.GetData] for the lifetime rules of the
+// underlying memory, which apply equally to the returned Image.
+//
+// Returns an error if the surface is closed, in an error state, or has an
+// invalid format.
+func (s *ImageSurface) Image() (*Image, error) {
+	data, err := s.GetData()
+	if err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.ptr == nil {
+		return nil, status.NullPointer
+	}
+
+	return &Image{
+		data:   data,
+		stride: imageSurfaceGetStride(s.ptr),
+		format: s.format,
+		width:  s.width,
+		height: s.height,
+	}, nil
+}
+
+// ColorModel returns the color model matching the surface's pixel format.
+func (img *Image) ColorModel() color.Model {
+	switch img.format {
+	case FormatARGB32:
+		return color.RGBAModel
+	case FormatRGB24:
+		return rgb24Model
+	case FormatA8:
+		return color.AlphaModel
+	case FormatA1:
+		return a1Model
+	case FormatRGB16_565:
+		return rgb16Model
+	case FormatRGB30:
+		return rgb30Model
+	default:
+		return color.RGBAModel
+	}
+}
+
+// Bounds returns the rectangle covering every pixel of the surface,
+// with its origin at (0, 0).
+func (img *Image) Bounds() image.Rectangle {
+	return image.Rect(0, 0, img.width, img.height)
+}
+
+// At returns the color of the pixel at (x, y). Points outside the bounds
+// return a fully transparent color.
+func (img *Image) At(x, y int) color.Color {
+	if !(image.Point{X: x, Y: y}.In(img.Bounds())) {
+		return color.RGBA{}
+	}
+
+	row := img.data[y*img.stride:]
+
+	switch img.format {
+	case FormatARGB32:
+		p := binary.NativeEndian.Uint32(row[x*4:])
+		return color.RGBA{R: uint8(p >> 16), G: uint8(p >> 8), B: uint8(p), A: uint8(p >> 24)}
+	case FormatRGB24:
+		p := binary.NativeEndian.Uint32(row[x*4:])
+		return color.RGBA{R: uint8(p >> 16), G: uint8(p >> 8), B: uint8(p), A: 0xff}
+	case FormatA8:
+		return color.Alpha{A: row[x]}
+	case FormatA1:
+		if a1Bit(row, x) {
+			return color.Alpha{A: 0xff}
+		}
+		return color.Alpha{}
+	case FormatRGB16_565:
+		p := binary.NativeEndian.Uint16(row[x*2:])
+		r, g, b := uint8(p>>11&0x1f), uint8(p>>5&0x3f), uint8(p&0x1f)
+		return color.RGBA{R: r<<3 | r>>2, G: g<<2 | g>>4, B: b<<3 | b>>2, A: 0xff}
+	case FormatRGB30:
+		p := binary.NativeEndian.Uint32(row[x*4:])
+		r, g, b := uint16(p>>20&0x3ff), uint16(p>>10&0x3ff), uint16(p&0x3ff)
+		return color.RGBA64{R: r<<6 | r>>4, G: g<<6 | g>>4, B: b<<6 | b>>4, A: 0xffff}
+	default:
+		return color.RGBA{}
+	}
+}
+
+// Set sets the pixel at (x, y) to c, converted to the surface's pixel format.
+// Points outside the bounds are ignored.
+//
+// Call MarkDirty (or MarkDirtyRectangle) on the surface after a batch of Set
+// calls so Cairo picks up the changes.
+func (img *Image) Set(x, y int, c color.Color) {
+	if !(image.Point{X: x, Y: y}.In(img.Bounds())) {
+		return
+	}
+
+	row := img.data[y*img.stride:]
+
+	switch img.format {
+	case FormatARGB32:
+		p := color.RGBAModel.Convert(c).(color.RGBA)
+		binary.NativeEndian.PutUint32(row[x*4:],
+			uint32(p.A)<<24|uint32(p.R)<<16|uint32(p.G)<<8|uint32(p.B))
+	case FormatRGB24:
+		p := rgb24Model.Convert(c).(color.RGBA)
+		binary.NativeEndian.PutUint32(row[x*4:],
+			uint32(p.R)<<16|uint32(p.G)<<8|uint32(p.B))
+	case FormatA8:
+		row[x] = color.AlphaModel.Convert(c).(color.Alpha).A
+	case FormatA1:
+		setA1Bit(row, x, a1Model.Convert(c).(color.Alpha).A != 0)
+	case FormatRGB16_565:
+		p := rgb24Model.Convert(c).(color.RGBA)
+		binary.NativeEndian.PutUint16(row[x*2:],
+			uint16(p.R>>3)<<11|uint16(p.G>>2)<<5|uint16(p.B>>3))
+	case FormatRGB30:
+		p := rgb30Model.Convert(c).(color.RGBA64)
+		binary.NativeEndian.PutUint32(row[x*4:],
+			uint32(p.R>>6)<<20|uint32(p.G>>6)<<10|uint32(p.B>>6))
+	}
+}
+
+// Opaque reports whether every pixel of the image is fully opaque. This is
+// always true for formats without an alpha channel, which lets encoders such
+// as image/png skip writing alpha.
+func (img *Image) Opaque() bool {
+	switch img.format {
+	case FormatRGB24, FormatRGB16_565, FormatRGB30:
+		return true
+	default:
+		return false
+	}
+}
+
+// rgb24Model converts colors to opaque RGBA by compositing them over black,
+// which is how Cairo interprets premultiplied color written to an RGB surface.
+var rgb24Model = color.ModelFunc(func(c color.Color) color.Color {
+	p := color.RGBAModel.Convert(c).(color.RGBA)
+	p.A = 0xff
+	return p
+})
+
+// rgb16Model converts colors to the values representable in RGB16_565.
+var rgb16Model = color.ModelFunc(func(c color.Color) color.Color {
+	p := rgb24Model.Convert(c).(color.RGBA)
+	r, g, b := p.R>>3, p.G>>2, p.B>>3
+	return color.RGBA{R: r<<3 | r>>2, G: g<<2 | g>>4, B: b<<3 | b>>2, A: 0xff}
+})
+
+// rgb30Model converts colors to opaque RGBA64 with 10 significant bits per channel.
+var rgb30Model = color.ModelFunc(func(c color.Color) color.Color {
+	r, g, b, _ := c.RGBA()
+	r, g, b = r>>6, g>>6, b>>6
+	return color.RGBA64{
+		R: uint16(r<<6 | r>>4),
+		G: uint16(g<<6 | g>>4),
+		B: uint16(b<<6 | b>>4),
+		A: 0xffff,
+	}
+})
+
+// a1Model converts colors to fully opaque or fully transparent alpha.
+var a1Model = color.ModelFunc(func(c color.Color) color.Color {
+	_, _, _, a := c.RGBA()
+	if a >= 0x8000 {
+		return color.Alpha{A: 0xff}
+	}
+	return color.Alpha{}
+})
+
+// littleEndian reports whether the host stores multi-byte values least
+// significant byte first. Cairo packs A1 pixels into native-endian 32-bit
+// words, with the first pixel in the least significant bit on little-endian
+// hosts and in the most significant bit on big-endian hosts.
+var littleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+func a1Shift(x int) uint {
+	if littleEndian {
+		return uint(x % 32)
+	}
+	return uint(31 - x%32)
+}
+
+func a1Bit(row []byte, x int) bool {
+	word := binary.NativeEndian.Uint32(row[(x/32)*4:])
+	return word>>a1Shift(x)&1 != 0
+}
+
+func setA1Bit(row []byte, x int, on bool) {
+	off := (x / 32) * 4
+	word := binary.NativeEndian.Uint32(row[off:])
+	if on {
+		word |= 1 << a1Shift(x)
+	} else {
+		word &^= 1 << a1Shift(x)
+	}
+	binary.NativeEndian.PutUint32(row[off:], word)
+}
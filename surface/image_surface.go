@@ -1,11 +1,18 @@
 package surface
 
-import "github.com/mikowitz/cairo/status"
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+
+	"github.com/mikowitz/cairo/status"
+)
 
 type ImageSurface struct {
 	*BaseSurface
 	format        Format
 	width, height int
+	stride        int
 }
 
 func NewImageSurface(format Format, width, height int) (*ImageSurface, error) {
@@ -23,9 +30,118 @@ func NewImageSurface(format Format, width, height int) (*ImageSurface, error) {
 		format:      format,
 		width:       width,
 		height:      height,
+		stride:      format.StrideForWidth(width),
 	}, nil
 }
 
+// NewImageSurfaceForData creates an image surface that draws directly into
+// the caller-provided pixel buffer data, instead of allocating its own.
+//
+// The buffer must hold at least stride*height bytes laid out as described by
+// format. The stride is the number of bytes between the starts of adjacent
+// rows; it must be at least format.StrideForWidth(width) and suitably
+// aligned, so computing it with [Format.StrideForWidth] is recommended.
+// Initial contents of data are used as-is; they are not cleared.
+//
+// The buffer is pinned in memory (see [runtime.Pinner]) for as long as Cairo
+// holds a reference to the surface, which may outlive Close if a context
+// still references the surface. While pinned, the buffer can be read and
+// written from Go, following the same Flush/MarkDirty rules as
+// [ImageSurface.GetData].
+//
+// Returns status.InvalidSize if data is empty or too small for the given
+// dimensions, or the Cairo error (such as status.InvalidStride or
+// status.InvalidFormat) if the surface cannot be created.
+//
+// Example:
+//
+//	stride := surface.FormatARGB32.StrideForWidth(320)
+//	pixels := make([]byte, stride*240)
+//	surf, err := surface.NewImageSurfaceForData(pixels, surface.FormatARGB32, 320, 240, stride)
+//	if err != nil {
+//		return err
+//	}
+//	defer surf.Close()
+func NewImageSurfaceForData(data []byte, format Format, width, height, stride int) (*ImageSurface, error) {
+	if stride < 0 || height < 0 || len(data) == 0 || len(data) < stride*height {
+		return nil, status.InvalidSize
+	}
+
+	ptr, st := imageSurfaceCreateForData(data, format, width, height, stride)
+	if st != status.Success {
+		return nil, st
+	}
+
+	return &ImageSurface{
+		BaseSurface: newBaseSurface(ptr),
+		format:      format,
+		width:       width,
+		height:      height,
+		stride:      stride,
+	}, nil
+}
+
+// NewImageSurfaceFromImage creates a new FormatARGB32 image surface holding a
+// copy of img. The surface has the same width and height as img's bounds, with
+// the bounds' minimum point mapped to the surface origin.
+//
+// Pixels are converted to Cairo's premultiplied ARGB32 layout; *image.RGBA
+// sources, which are already premultiplied, are copied without per-pixel
+// color conversion. The surface owns its memory, so img may be modified or
+// discarded afterwards.
+//
+// Returns status.NullPointer if img is nil, or the Cairo error if the surface
+// cannot be created (for example, status.InvalidSize for oversized images).
+//
+// Example:
+//
+//	src, _, err := image.Decode(f)
+//	if err != nil {
+//		return err
+//	}
+//	surf, err := surface.NewImageSurfaceFromImage(src)
+func NewImageSurfaceFromImage(img image.Image) (*ImageSurface, error) {
+	if img == nil {
+		return nil, status.NullPointer
+	}
+
+	b := img.Bounds()
+	s, err := NewImageSurface(FormatARGB32, b.Dx(), b.Dy())
+	if err != nil {
+		return nil, err
+	}
+
+	dst, err := s.Image()
+	if err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+
+	if rgba, ok := img.(*image.RGBA); ok {
+		copyRGBA(dst, rgba)
+	} else {
+		draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	}
+	s.MarkDirty()
+
+	return s, nil
+}
+
+// copyRGBA copies premultiplied RGBA pixels into an ARGB32 destination
+// without going through color.Color conversions.
+func copyRGBA(dst *Image, src *image.RGBA) {
+	b := src.Bounds()
+	for y := 0; y < b.Dy(); y++ {
+		in := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+		out := dst.data[y*dst.stride:]
+		for x := 0; x < b.Dx(); x++ {
+			r, g, bl, a := in[x*4], in[x*4+1], in[x*4+2], in[x*4+3]
+			binary.NativeEndian.PutUint32(out[x*4:],
+				uint32(a)<<24|uint32(r)<<16|uint32(g)<<8|uint32(bl))
+		}
+	}
+}
+
 func (s *ImageSurface) GetFormat() Format {
 	s.RLock()
 	defer s.RUnlock()
@@ -51,5 +167,54 @@ func (s *ImageSurface) GetStride() int {
 	s.RLock()
 	defer s.RUnlock()
 
-	return s.format.StrideForWidth(s.width)
+	return s.stride
+}
+
+// GetData returns the pixel buffer of the image surface as a byte slice.
+//
+// The slice is backed directly by Cairo's memory; no copy is made. It is
+// GetStride() * GetHeight() bytes long, with each row starting at a multiple
+// of the stride. The layout of each pixel is described by the surface's
+// [Format]; 32-bit and 16-bit pixels are stored in native byte order.
+//
+// GetData flushes the surface before returning, so all pending Cairo drawing
+// is visible in the returned slice. If you modify the pixels directly, call
+// [BaseSurface.MarkDirty] or [BaseSurface.MarkDirtyRectangle] afterwards so
+// Cairo rereads any cached areas. If you draw with Cairo again after reading,
+// call GetData (or Flush) again before the next direct access.
+//
+// The slice is only valid until the surface is closed. Retaining or using it
+// after Close results in undefined behavior.
+//
+// Returns status.NullPointer if the surface has been closed, the surface's
+// error status if it is in an error state, or status.SurfaceFinished if the
+// surface no longer has pixel data.
+//
+// Example:
+//
+//	data, err := surf.GetData()
+//	if err != nil {
+//		return err
+//	}
+//	data[0] = 0xff // modify the first byte of the first pixel
+//	surf.MarkDirty()
+func (s *ImageSurface) GetData() ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.ptr == nil {
+		return nil, status.NullPointer
+	}
+
+	if st := surfaceStatus(s.ptr); st != status.Success {
+		return nil, st
+	}
+
+	surfaceFlush(s.ptr)
+
+	data := imageSurfaceGetData(s.ptr)
+	if data == nil {
+		return nil, status.SurfaceFinished
+	}
+	return data, nil
 }
@@ -0,0 +1,151 @@
+// ABOUTME: Tests for ImageSurface constructors that take Go-owned pixels.
+// ABOUTME: Covers NewImageSurfaceForData validation and NewImageSurfaceFromImage conversion.
+
+package surface
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"runtime"
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewImageSurfaceForData verifies a surface can wrap a caller buffer and
+// that pixel writes through the surface land in that buffer.
+func TestNewImageSurfaceForData(t *testing.T) {
+	stride := FormatARGB32.StrideForWidth(20)
+	buf := make([]byte, stride*10)
+
+	s, err := NewImageSurfaceForData(buf, FormatARGB32, 20, 10, stride)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, status.Success, s.Status())
+	assert.Equal(t, 20, s.GetWidth())
+	assert.Equal(t, 10, s.GetHeight())
+	assert.Equal(t, stride, s.GetStride())
+
+	img, err := s.Image()
+	require.NoError(t, err)
+	img.Set(1, 1, color.RGBA{R: 0xff, A: 0xff})
+	s.MarkDirty()
+
+	assert.Equal(t, uint32(0xffff0000), binary.NativeEndian.Uint32(buf[stride+4:]))
+}
+
+// TestNewImageSurfaceForDataCustomStride verifies padded rows are honored.
+func TestNewImageSurfaceForDataCustomStride(t *testing.T) {
+	stride := FormatA8.StrideForWidth(10) + 16
+	buf := make([]byte, stride*4)
+
+	s, err := NewImageSurfaceForData(buf, FormatA8, 10, 4, stride)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, stride, s.GetStride())
+
+	data, err := s.GetData()
+	require.NoError(t, err)
+	assert.Len(t, data, stride*4)
+}
+
+// TestNewImageSurfaceForDataInvalid verifies argument validation.
+func TestNewImageSurfaceForDataInvalid(t *testing.T) {
+	stride := FormatARGB32.StrideForWidth(10)
+
+	tests := []struct {
+		name   string
+		data   []byte
+		format Format
+		width  int
+		height int
+		stride int
+		want   status.Status
+	}{
+		{"empty buffer", nil, FormatARGB32, 10, 10, stride, status.InvalidSize},
+		{"buffer too small", make([]byte, stride*9), FormatARGB32, 10, 10, stride, status.InvalidSize},
+		{"negative height", make([]byte, stride), FormatARGB32, 10, -1, stride, status.InvalidSize},
+		{"stride too small", make([]byte, stride*10), FormatARGB32, 10, 10, stride - 4, status.InvalidStride},
+		{"invalid format", make([]byte, stride*10), FormatInvalid, 10, 10, stride, status.InvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewImageSurfaceForData(tt.data, tt.format, tt.width, tt.height, tt.stride)
+			assert.Nil(t, s)
+			assert.Equal(t, tt.want, err)
+		})
+	}
+}
+
+// TestNewImageSurfaceForDataOutlivesClose verifies the buffer stays valid for
+// Cairo after the Go surface is closed and collected.
+func TestNewImageSurfaceForDataOutlivesClose(t *testing.T) {
+	for range 50 {
+		stride := FormatARGB32.StrideForWidth(64)
+		s, err := NewImageSurfaceForData(make([]byte, stride*64), FormatARGB32, 64, 64, stride)
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+	}
+	runtime.GC()
+	runtime.GC()
+}
+
+// TestNewImageSurfaceFromImage verifies RGBA and non-RGBA sources are copied
+// with premultiplied colors and bounds translated to the origin.
+func TestNewImageSurfaceFromImage(t *testing.T) {
+	rgba := image.NewRGBA(image.Rect(5, 5, 15, 13))
+	rgba.SetRGBA(5, 5, color.RGBA{R: 0x80, G: 0x00, B: 0x00, A: 0x80})
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 10, 8))
+	nrgba.SetNRGBA(0, 0, color.NRGBA{R: 0xff, G: 0x00, B: 0x00, A: 0x80})
+
+	gray := image.NewGray(image.Rect(0, 0, 10, 8))
+	gray.SetGray(0, 0, color.Gray{Y: 0x40})
+
+	tests := []struct {
+		name string
+		img  image.Image
+		want color.RGBA
+	}{
+		{"RGBA", rgba, color.RGBA{R: 0x80, A: 0x80}},
+		{"NRGBA", nrgba, color.RGBA{R: 0x80, A: 0x80}},
+		{"Gray", gray, color.RGBA{R: 0x40, G: 0x40, B: 0x40, A: 0xff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewImageSurfaceFromImage(tt.img)
+			require.NoError(t, err)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			assert.Equal(t, FormatARGB32, s.GetFormat())
+			assert.Equal(t, 10, s.GetWidth())
+			assert.Equal(t, 8, s.GetHeight())
+
+			img, err := s.Image()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, img.At(0, 0))
+			assert.Equal(t, color.RGBA{}, img.At(1, 1))
+		})
+	}
+}
+
+// TestNewImageSurfaceFromImageNil verifies a nil image is rejected.
+func TestNewImageSurfaceFromImageNil(t *testing.T) {
+	s, err := NewImageSurfaceFromImage(nil)
+	assert.Nil(t, s)
+	assert.Equal(t, status.NullPointer, err)
+}
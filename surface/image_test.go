@@ -0,0 +1,175 @@
+// ABOUTME: Tests for ImageSurface pixel access via GetData and the image.Image adapter.
+// ABOUTME: Covers every pixel format round-trip plus PNG encoding through the standard library.
+
+package surface
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImageSurfaceGetData verifies the returned buffer has stride*height bytes
+// and starts out fully transparent.
+func TestImageSurfaceGetData(t *testing.T) {
+	s := createTestSurface(t)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	data, err := s.GetData()
+	require.NoError(t, err)
+	assert.Len(t, data, s.GetStride()*s.GetHeight())
+
+	for i, b := range data {
+		if b != 0 {
+			t.Fatalf("byte %d should be zero on a new surface, got %d", i, b)
+		}
+	}
+}
+
+// TestImageSurfaceGetDataWriteBack verifies that writes through the slice are
+// visible to a second GetData call after MarkDirty.
+func TestImageSurfaceGetDataWriteBack(t *testing.T) {
+	s := createTestSurface(t)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	data, err := s.GetData()
+	require.NoError(t, err)
+	data[0] = 0x7f
+	s.MarkDirty()
+
+	again, err := s.GetData()
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x7f), again[0])
+	assert.Equal(t, status.Success, s.Status())
+}
+
+// TestImageSurfaceGetDataClosed verifies GetData on a closed surface returns NullPointer.
+func TestImageSurfaceGetDataClosed(t *testing.T) {
+	s := createTestSurface(t)
+	require.NoError(t, s.Close())
+
+	data, err := s.GetData()
+	assert.Nil(t, data)
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestImageAdapterRoundTrip verifies that Set followed by At returns the
+// expected color for every supported pixel format.
+func TestImageAdapterRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		in     color.Color
+		want   color.Color
+	}{
+		{"ARGB32 opaque", FormatARGB32, color.RGBA{R: 0xff, G: 0x80, B: 0x00, A: 0xff}, color.RGBA{R: 0xff, G: 0x80, B: 0x00, A: 0xff}},
+		{"ARGB32 premultiplied", FormatARGB32, color.RGBA{R: 0x40, G: 0x00, B: 0x40, A: 0x80}, color.RGBA{R: 0x40, G: 0x00, B: 0x40, A: 0x80}},
+		{"RGB24", FormatRGB24, color.RGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}, color.RGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}},
+		{"A8", FormatA8, color.Alpha{A: 0x42}, color.Alpha{A: 0x42}},
+		{"A1 on", FormatA1, color.Alpha{A: 0xff}, color.Alpha{A: 0xff}},
+		{"A1 off", FormatA1, color.Alpha{A: 0x10}, color.Alpha{}},
+		{"RGB16_565", FormatRGB16_565, color.RGBA{R: 0xff, G: 0x00, B: 0xff, A: 0xff}, color.RGBA{R: 0xff, G: 0x00, B: 0xff, A: 0xff}},
+		{"RGB30", FormatRGB30, color.RGBA64{R: 0xffff, G: 0x0000, B: 0xffff, A: 0xffff}, color.RGBA64{R: 0xffff, G: 0x0000, B: 0xffff, A: 0xffff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewImageSurface(tt.format, 40, 4)
+			require.NoError(t, err)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			img, err := s.Image()
+			require.NoError(t, err)
+
+			img.Set(33, 2, tt.in)
+			s.MarkDirty()
+
+			assert.Equal(t, tt.want, img.At(33, 2))
+			assert.Equal(t, image.Rect(0, 0, 40, 4), img.Bounds())
+		})
+	}
+}
+
+// TestImageAdapterDrawUsesNativeARGB verifies that draw.Draw through the
+// adapter stores pixels in Cairo's native-endian ARGB32 layout.
+func TestImageAdapterDrawUsesNativeARGB(t *testing.T) {
+	s := createTestSurface(t)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	pat := image.NewUniform(color.RGBA{R: 0, G: 0, B: 0xff, A: 0xff})
+	img, err := s.Image()
+	require.NoError(t, err)
+	draw.Draw(img, image.Rect(0, 0, 10, 10), pat, image.Point{}, draw.Src)
+	s.MarkDirty()
+
+	data, err := s.GetData()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{B: 0xff, A: 0xff}, img.At(5, 5))
+	assert.Equal(t, color.RGBA{}, img.At(50, 50))
+	assert.Equal(t, uint32(0xff0000ff), binary.NativeEndian.Uint32(data[0:4]))
+}
+
+// TestImageAdapterOutOfBounds verifies out-of-range At and Set are harmless.
+func TestImageAdapterOutOfBounds(t *testing.T) {
+	s := createTestSurface(t)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	img, err := s.Image()
+	require.NoError(t, err)
+
+	img.Set(-1, 0, color.White)
+	img.Set(0, 100, color.White)
+	assert.Equal(t, color.RGBA{}, img.At(-1, -1))
+	assert.Equal(t, color.RGBA{}, img.At(100, 0))
+}
+
+// TestImageAdapterPNGEncode verifies the adapter can be passed to image/png.
+func TestImageAdapterPNGEncode(t *testing.T) {
+	for _, format := range []Format{FormatARGB32, FormatRGB24, FormatA8, FormatA1, FormatRGB16_565, FormatRGB30} {
+		t.Run(format.String(), func(t *testing.T) {
+			s, err := NewImageSurface(format, 16, 16)
+			require.NoError(t, err)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			img, err := s.Image()
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, png.Encode(&buf, img))
+
+			decoded, err := png.Decode(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, img.Bounds(), decoded.Bounds())
+		})
+	}
+}
+
+// TestImageAdapterClosedSurface verifies Image on a closed surface returns an error.
+func TestImageAdapterClosedSurface(t *testing.T) {
+	s := createTestSurface(t)
+	require.NoError(t, s.Close())
+
+	img, err := s.Image()
+	assert.Nil(t, img)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -5,17 +5,22 @@
 
 package surface
 
-import "github.com/mikowitz/cairo/status"
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/status"
+)
 
 // PDFSurface is a surface that writes drawing operations to a PDF file.
 // Dimensions are specified in points, where 1 point equals 1/72 of an inch.
 // The coordinate origin is at the top-left corner of each page.
 //
-// Use NewPDFSurface to create a PDF surface. Call ShowPage to end one page
-// and begin the next. Close the surface when finished to flush and finalize
-// the PDF file.
+// Use NewPDFSurface to create a PDF surface, or NewPDFSurfaceForWriter to
+// write the document to an io.Writer. Call ShowPage to end one page and begin
+// the next. Close the surface when finished to flush and finalize the PDF file.
 type PDFSurface struct {
 	*BaseSurface
+	stream *streamWriter
 }
 
 // NewPDFSurface creates a new PDF surface writing to filename.
@@ -31,6 +36,37 @@ func NewPDFSurface(filename string, widthPt, heightPt float64) (*PDFSurface, err
 	return &PDFSurface{BaseSurface: newBaseSurface(ptr)}, nil
 }
 
+// NewPDFSurfaceForWriter creates a new PDF surface writing the document to w,
+// such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+//
+// Output is written to w as Cairo produces it, and most of the document is
+// written when the surface is closed. Close any contexts drawing on the
+// surface before closing it, since Close finishes the document. Close returns
+// status.WriteError if any write to w failed.
+//
+// Returns status.NullPointer if w is nil, or the Cairo error if the surface
+// cannot be created.
+func NewPDFSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*PDFSurface, error) {
+	if w == nil {
+		return nil, status.NullPointer
+	}
+
+	stream := newStreamWriter(w)
+	ptr, st := pdfSurfaceCreateForStream(stream, widthPt, heightPt)
+	if st != status.Success {
+		return nil, st
+	}
+	return &PDFSurface{BaseSurface: newBaseSurface(ptr), stream: stream}, nil
+}
+
+// Close closes the surface and finalizes the PDF document. For surfaces
+// created with NewPDFSurfaceForWriter, the remaining output is written before
+// Close returns, and status.WriteError is returned if any write failed.
+func (s *PDFSurface) Close() error {
+	return s.closeStream(s.stream)
+}
+
 // SetSize changes the page size for subsequent pages in the PDF document.
 // widthPt and heightPt are in points (1/72 inch).
 // This has no effect on already-emitted pages.
@@ -1,5 +1,5 @@
 // ABOUTME: CGO bindings for Cairo PDF surface creation and page size management.
-// ABOUTME: Wraps cairo_pdf_surface_create and cairo_pdf_surface_set_size.
+// ABOUTME: Wraps cairo_pdf_surface_create, its stream variant, and cairo_pdf_surface_set_size.
 
 //go:build !nopdf
 
@@ -7,9 +7,20 @@ package surface
 
 // #cgo pkg-config: cairo-pdf
 // #include <cairo-pdf.h>
+// #include <stdint.h>
 // #include <stdlib.h>
+//
+// extern cairo_status_t goSurfaceWriteStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_surface_t *_pdfSurfaceCreateForStream(uintptr_t h, double width, double height) {
+//     return cairo_pdf_surface_create_for_stream((cairo_write_func_t)goSurfaceWriteStream, (void *)h, width, height);
+// }
 import "C"
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
 
 func pdfSurfaceCreate(filename string, widthPt, heightPt float64) SurfacePtr {
 	cFilename := C.CString(filename)
@@ -17,6 +28,12 @@ func pdfSurfaceCreate(filename string, widthPt, heightPt float64) SurfacePtr {
 	return SurfacePtr(C.cairo_pdf_surface_create(cFilename, C.double(widthPt), C.double(heightPt)))
 }
 
+func pdfSurfaceCreateForStream(w *streamWriter, widthPt, heightPt float64) (SurfacePtr, status.Status) {
+	return surfaceCreateForStream(w, func(h C.uintptr_t) SurfacePtr {
+		return SurfacePtr(C._pdfSurfaceCreateForStream(h, C.double(widthPt), C.double(heightPt)))
+	})
+}
+
 func pdfSurfaceSetSize(ptr SurfacePtr, widthPt, heightPt float64) {
 	C.cairo_pdf_surface_set_size(ptr, C.double(widthPt), C.double(heightPt))
 }
@@ -6,10 +6,12 @@
 package surface
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/mikowitz/cairo/font"
 	"github.com/mikowitz/cairo/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +37,36 @@ func TestNewPDFSurface(t *testing.T) {
 	assert.Greater(t, info.Size(), int64(0), "PDF file should not be empty")
 }
 
+// TestPDFSurfaceFontOptions verifies PDF surfaces disable hinting, so text
+// layout does not depend on a device resolution.
+func TestPDFSurfaceFontOptions(t *testing.T) {
+	s, err := NewPDFSurfaceForWriter(&bytes.Buffer{}, 595, 842)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	opts, err := s.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = opts.Close()
+	}()
+	assert.Equal(t, font.HintStyleNone, opts.GetHintStyle())
+	assert.Equal(t, font.HintMetricsOff, opts.GetHintMetrics())
+}
+
+// TestPDFSurfaceHasShowTextGlyphs verifies PDF surfaces keep the text
+// passed to ShowTextGlyphs.
+func TestPDFSurfaceHasShowTextGlyphs(t *testing.T) {
+	s, err := NewPDFSurfaceForWriter(&bytes.Buffer{}, 595, 842)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.True(t, s.HasShowTextGlyphs())
+}
+
 // TestNewPDFSurfaceInvalidPath verifies that an invalid path returns an error.
 func TestNewPDFSurfaceInvalidPath(t *testing.T) {
 	_, err := NewPDFSurface("/nonexistent/dir/test.pdf", 595, 842)
@@ -101,3 +133,51 @@ func TestPDFSurfaceShowPageClosed(t *testing.T) {
 	// Should not panic.
 	s.ShowPage()
 }
+
+// TestNewPDFSurfaceForWriter verifies a PDF document is written to an io.Writer
+// by the time Close returns.
+func TestNewPDFSurfaceForWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	s, err := NewPDFSurfaceForWriter(&buf, 595, 842)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, status.Success, s.Status())
+
+	s.ShowPage()
+	s.SetSize(612, 792)
+	s.ShowPage()
+	require.NoError(t, s.Close())
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")), "output should start with a PDF header")
+	assert.Contains(t, buf.String(), "%%EOF")
+
+	// Closing again is a no-op.
+	require.NoError(t, s.Close())
+}
+
+// TestNewPDFSurfaceForWriterWriteError verifies writer failures are reported by Close.
+func TestNewPDFSurfaceForWriterWriteError(t *testing.T) {
+	s, err := NewPDFSurfaceForWriter(failingWriter{}, 595, 842)
+	require.NoError(t, err)
+
+	s.ShowPage()
+	assert.Equal(t, status.WriteError, s.Close())
+}
+
+// TestNewPDFSurfaceForWriterPanic verifies a panicking writer is reported by
+// Close instead of unwinding through Cairo.
+func TestNewPDFSurfaceForWriterPanic(t *testing.T) {
+	s, err := NewPDFSurfaceForWriter(panickingWriter{}, 595, 842)
+	require.NoError(t, err)
+
+	s.ShowPage()
+	assert.Equal(t, status.WriteError, s.Close())
+}
+
+// TestNewPDFSurfaceForWriterNil verifies a nil writer is rejected.
+func TestNewPDFSurfaceForWriterNil(t *testing.T) {
+	s, err := NewPDFSurfaceForWriter(nil, 595, 842)
+	assert.Nil(t, s)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,60 @@
+// ABOUTME: Loads PNG images into ImageSurfaces from files or io.Readers.
+// ABOUTME: Wraps cairo_image_surface_create_from_png and its stream variant.
+
+package surface
+
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// NewImageSurfaceFromPNG creates a new image surface holding the contents of
+// the PNG file at filename. The surface format, width and height are taken
+// from the image; use GetFormat, GetWidth and GetHeight to inspect them.
+//
+// Returns status.FileNotFound if the file does not exist, status.ReadError
+// if it cannot be read, or status.PngError if it is not a valid PNG image.
+//
+// Example:
+//
+//	tex, err := surface.NewImageSurfaceFromPNG("texture.png")
+//	if err != nil {
+//		return err
+//	}
+//	defer tex.Close()
+func NewImageSurfaceFromPNG(filename string) (*ImageSurface, error) {
+	return newImageSurfaceFromPtr(imageSurfaceCreateFromPNG(filename))
+}
+
+// NewImageSurfaceFromPNGReader creates a new image surface from PNG data read
+// from r. Cairo reads exactly as many bytes as the image needs; r is not
+// closed.
+//
+// Returns status.NullPointer if r is nil, status.ReadError if r returns an
+// error (including io.ErrUnexpectedEOF for truncated data) or panics, or
+// status.PngError if the data is not a valid PNG image.
+func NewImageSurfaceFromPNGReader(r io.Reader) (*ImageSurface, error) {
+	if r == nil {
+		return nil, status.NullPointer
+	}
+	return newImageSurfaceFromPtr(imageSurfaceCreateFromPNGStream(r))
+}
+
+// newImageSurfaceFromPtr wraps an image surface created by Cairo, reading its
+// dimensions back from the C surface.
+func newImageSurfaceFromPtr(ptr SurfacePtr) (*ImageSurface, error) {
+	st := surfaceStatus(ptr)
+	if st != status.Success {
+		surfaceClose(ptr)
+		return nil, st
+	}
+
+	return &ImageSurface{
+		BaseSurface: newBaseSurface(ptr),
+		format:      imageSurfaceGetFormat(ptr),
+		width:       imageSurfaceGetWidth(ptr),
+		height:      imageSurfaceGetHeight(ptr),
+		stride:      imageSurfaceGetStride(ptr),
+	}, nil
+}
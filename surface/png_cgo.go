@@ -0,0 +1,36 @@
+// ABOUTME: CGO bindings for reading PNG images into Cairo image surfaces.
+// ABOUTME: The stream variant passes the io.Reader to C through a cgo.Handle.
+
+package surface
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+// #include <stdint.h>
+// #include <stdlib.h>
+//
+// extern cairo_status_t goSurfaceReadStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_surface_t *_imageSurfaceCreateFromPNGStream(uintptr_t h) {
+//     return cairo_image_surface_create_from_png_stream(goSurfaceReadStream, (void *)h);
+// }
+import "C"
+
+import (
+	"io"
+	"runtime/cgo"
+	"unsafe"
+)
+
+func imageSurfaceCreateFromPNG(filename string) SurfacePtr {
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	return SurfacePtr(C.cairo_image_surface_create_from_png(cFilename))
+}
+
+// imageSurfaceCreateFromPNGStream reads a PNG from r. Cairo only calls back
+// into r during this call, so the handle does not outlive it.
+func imageSurfaceCreateFromPNGStream(r io.Reader) SurfacePtr {
+	h := cgo.NewHandle(r)
+	defer h.Delete()
+	return SurfacePtr(C._imageSurfaceCreateFromPNGStream(C.uintptr_t(h)))
+}
@@ -0,0 +1,226 @@
+// ABOUTME: Tests for loading PNG images into ImageSurfaces from files and readers.
+// ABOUTME: Uses t.TempDir() for round-trip PNG files to ensure automatic cleanup.
+
+package surface
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingWriter is an io.Writer that always fails.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+// shortWriter is an io.Writer that accepts less than it is given.
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) {
+	return len(p) / 2, nil
+}
+
+// panickingReader is an io.Reader that panics.
+type panickingReader struct{}
+
+func (panickingReader) Read([]byte) (int, error) {
+	panic("read panicked")
+}
+
+// panickingWriter is an io.Writer that panics.
+type panickingWriter struct{}
+
+func (panickingWriter) Write([]byte) (int, error) {
+	panic("write panicked")
+}
+
+// encodeTestPNG returns a 4x3 opaque PNG with a red pixel at (1, 2).
+func encodeTestPNG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 3))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	img.SetNRGBA(1, 2, color.NRGBA{R: 0xff, A: 0xff})
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// TestNewImageSurfaceFromPNG verifies a PNG written by WriteToPNG can be read back.
+func TestNewImageSurfaceFromPNG(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "roundtrip.png")
+
+	src, err := NewImageSurface(FormatARGB32, 30, 20)
+	require.NoError(t, err)
+	img, err := src.Image()
+	require.NoError(t, err)
+	img.Set(3, 4, color.RGBA{G: 0xff, A: 0xff})
+	src.MarkDirty()
+	require.NoError(t, src.WriteToPNG(filename))
+	require.NoError(t, src.Close())
+
+	s, err := NewImageSurfaceFromPNG(filename)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, status.Success, s.Status())
+	assert.Equal(t, FormatARGB32, s.GetFormat())
+	assert.Equal(t, 30, s.GetWidth())
+	assert.Equal(t, 20, s.GetHeight())
+	assert.Equal(t, FormatARGB32.StrideForWidth(30), s.GetStride())
+
+	loaded, err := s.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{G: 0xff, A: 0xff}, loaded.At(3, 4))
+	assert.Equal(t, color.RGBA{}, loaded.At(0, 0))
+}
+
+// TestNewImageSurfaceFromPNGMissingFile verifies a missing file returns FileNotFound.
+func TestNewImageSurfaceFromPNGMissingFile(t *testing.T) {
+	s, err := NewImageSurfaceFromPNG(filepath.Join(t.TempDir(), "missing.png"))
+	assert.Nil(t, s)
+	assert.Equal(t, status.FileNotFound, err)
+}
+
+// TestNewImageSurfaceFromPNGReader verifies a PNG encoded by image/png can be
+// loaded from an io.Reader, with an opaque image producing an RGB24 surface.
+func TestNewImageSurfaceFromPNGReader(t *testing.T) {
+	s, err := NewImageSurfaceFromPNGReader(bytes.NewReader(encodeTestPNG(t)))
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, FormatRGB24, s.GetFormat())
+	assert.Equal(t, 4, s.GetWidth())
+	assert.Equal(t, 3, s.GetHeight())
+
+	img, err := s.Image()
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 0xff, A: 0xff}, img.At(1, 2))
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, img.At(0, 0))
+}
+
+// TestNewImageSurfaceFromPNGReaderOneByte verifies readers that return short
+// reads are handled.
+func TestNewImageSurfaceFromPNGReaderOneByte(t *testing.T) {
+	s, err := NewImageSurfaceFromPNGReader(iotest.OneByteReader(bytes.NewReader(encodeTestPNG(t))))
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, 4, s.GetWidth())
+}
+
+// TestNewImageSurfaceFromPNGReaderErrors verifies malformed input and reader
+// failures are reported as status errors.
+func TestNewImageSurfaceFromPNGReaderErrors(t *testing.T) {
+	data := encodeTestPNG(t)
+
+	tests := []struct {
+		name string
+		data []byte
+		want status.Status
+	}{
+		{"truncated", data[:len(data)/2], status.ReadError},
+		{"empty", nil, status.ReadError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewImageSurfaceFromPNGReader(bytes.NewReader(tt.data))
+			assert.Nil(t, s)
+			assert.Equal(t, tt.want, err)
+		})
+	}
+
+	t.Run("not a PNG", func(t *testing.T) {
+		s, err := NewImageSurfaceFromPNGReader(bytes.NewReader(bytes.Repeat([]byte("not a png"), 16)))
+		assert.Nil(t, s)
+		require.Error(t, err)
+		assert.IsType(t, status.Success, err)
+	})
+
+	t.Run("reader error", func(t *testing.T) {
+		s, err := NewImageSurfaceFromPNGReader(iotest.ErrReader(errors.New("boom")))
+		assert.Nil(t, s)
+		assert.Equal(t, status.ReadError, err)
+	})
+
+	t.Run("reader panic", func(t *testing.T) {
+		s, err := NewImageSurfaceFromPNGReader(panickingReader{})
+		assert.Nil(t, s)
+		assert.Equal(t, status.ReadError, err)
+	})
+
+	t.Run("nil reader", func(t *testing.T) {
+		s, err := NewImageSurfaceFromPNGReader(nil)
+		assert.Nil(t, s)
+		assert.Equal(t, status.NullPointer, err)
+	})
+}
+
+// TestWriteToPNGWriter verifies PNG output written to an io.Writer decodes to
+// the surface contents and can be loaded back by Cairo.
+func TestWriteToPNGWriter(t *testing.T) {
+	s, err := NewImageSurface(FormatARGB32, 16, 8)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	img, err := s.Image()
+	require.NoError(t, err)
+	img.Set(2, 3, color.RGBA{B: 0xff, A: 0xff})
+	s.MarkDirty()
+
+	var buf bytes.Buffer
+	require.NoError(t, s.WriteToPNGWriter(&buf))
+
+	decoded, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 16, 8), decoded.Bounds())
+	r, g, b, a := decoded.At(2, 3).RGBA()
+	assert.Equal(t, []uint32{0, 0, 0xffff, 0xffff}, []uint32{r, g, b, a})
+
+	loaded, err := NewImageSurfaceFromPNGReader(&buf)
+	require.NoError(t, err)
+	defer func() {
+		_ = loaded.Close()
+	}()
+	assert.Equal(t, 16, loaded.GetWidth())
+}
+
+// TestWriteToPNGWriterErrors verifies writer failures surface as WriteError
+// and closed surfaces or nil writers as NullPointer.
+func TestWriteToPNGWriterErrors(t *testing.T) {
+	s := createTestSurface(t)
+
+	assert.Equal(t, status.WriteError, s.WriteToPNGWriter(failingWriter{}))
+	assert.Equal(t, status.WriteError, s.WriteToPNGWriter(shortWriter{}))
+	assert.Equal(t, status.WriteError, s.WriteToPNGWriter(panickingWriter{}))
+	assert.Equal(t, status.NullPointer, s.WriteToPNGWriter(nil))
+
+	require.NoError(t, s.Close())
+	var buf bytes.Buffer
+	assert.Equal(t, status.NullPointer, s.WriteToPNGWriter(&buf))
+	assert.Zero(t, buf.Len())
+}
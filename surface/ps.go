@@ -0,0 +1,203 @@
+// ABOUTME: PSSurface implementation for writing vector graphics to PostScript and EPS files.
+// ABOUTME: Dimensions are in points (1 point = 1/72 inch); supports DSC comments and level restriction.
+
+//go:build !nops
+
+package surface
+
+import (
+	"io"
+	"sync"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// PSLevel specifies the language level of the PostScript specification
+// used for generated output.
+// These values correspond directly to Cairo's cairo_ps_level_t enum.
+//
+//go:generate sh -c "stringer -type=PSLevel -tags '!nops' && awk '/^package /{print \"//go:build !nops\"; print \"\"; print; next}1' pslevel_string.go > /tmp/_ps_tmp.go && mv /tmp/_ps_tmp.go pslevel_string.go"
+type PSLevel int
+
+const (
+	// PSLevel2 generates output using language level 2 of the PostScript specification.
+	PSLevel2 PSLevel = iota
+	// PSLevel3 generates output using language level 3 of the PostScript specification.
+	PSLevel3
+)
+
+var (
+	psLevelsOnce   sync.Once
+	psLevelsResult []PSLevel
+)
+
+// PSLevels returns the list of PostScript language levels supported by the Cairo library.
+// The result is cached after the first call; Cairo's supported levels are fixed at build time.
+func PSLevels() []PSLevel {
+	psLevelsOnce.Do(func() {
+		psLevelsResult = psGetLevels()
+	})
+	return psLevelsResult
+}
+
+// PSLevelToString returns the human-readable name of the PostScript level
+// (e.g., "PS Level 2" or "PS Level 3"). Returns an empty string for unknown levels.
+func PSLevelToString(level PSLevel) string {
+	return psLevelToString(level)
+}
+
+// PSSurface is a surface that writes drawing operations to a PostScript file.
+// Dimensions are specified in points, where 1 point equals 1/72 of an inch.
+// The coordinate origin is at the top-left corner of each page.
+//
+// Use NewPSSurface to create a PostScript surface, or NewPSSurfaceForWriter
+// to write the document to an io.Writer. Call SetEPS to produce Encapsulated
+// PostScript instead. Call ShowPage to end one page and begin the next. Close
+// the surface when finished to flush and finalize the document.
+type PSSurface struct {
+	*BaseSurface
+	stream *streamWriter
+}
+
+// NewPSSurface creates a new PostScript surface writing to filename.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+// Returns an error if Cairo cannot create the surface (e.g., invalid path).
+func NewPSSurface(filename string, widthPt, heightPt float64) (*PSSurface, error) {
+	ptr := psSurfaceCreate(filename, widthPt, heightPt)
+	st := surfaceStatus(ptr)
+	if st != status.Success {
+		surfaceClose(ptr)
+		return nil, st
+	}
+	return &PSSurface{BaseSurface: newBaseSurface(ptr)}, nil
+}
+
+// NewPSSurfaceForWriter creates a new PostScript surface writing the document
+// to w, such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions of the first page in points (1/72 inch).
+//
+// Most of the document is written when the surface is closed. Close any
+// contexts drawing on the surface before closing it, since Close finishes the
+// document. Close returns status.WriteError if any write to w failed.
+//
+// Returns status.NullPointer if w is nil, or the Cairo error if the surface
+// cannot be created.
+func NewPSSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*PSSurface, error) {
+	if w == nil {
+		return nil, status.NullPointer
+	}
+
+	stream := newStreamWriter(w)
+	ptr, st := psSurfaceCreateForStream(stream, widthPt, heightPt)
+	if st != status.Success {
+		return nil, st
+	}
+	return &PSSurface{BaseSurface: newBaseSurface(ptr), stream: stream}, nil
+}
+
+// Close closes the surface and finalizes the PostScript document. For
+// surfaces created with NewPSSurfaceForWriter, the remaining output is written
+// before Close returns, and status.WriteError is returned if any write failed.
+func (s *PSSurface) Close() error {
+	return s.closeStream(s.stream)
+}
+
+// SetSize changes the page size for subsequent pages in the PostScript document.
+// widthPt and heightPt are in points (1/72 inch).
+// Call SetSize before any drawing on the page it should apply to; it has no
+// effect on already-emitted pages.
+func (s *PSSurface) SetSize(widthPt, heightPt float64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceSetSize(s.ptr, widthPt, heightPt)
+}
+
+// ShowPage emits the current page and starts a new page in the PostScript document.
+// After calling ShowPage, subsequent drawing operations apply to the new page.
+func (s *PSSurface) ShowPage() {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	surfaceShowPage(s.ptr)
+}
+
+// RestrictToLevel restricts the generated PostScript to the given language level.
+// Must be called before any drawing operations; it has no effect on already-emitted output.
+// Use PSLevels to query which levels are available.
+func (s *PSSurface) RestrictToLevel(level PSLevel) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceRestrictToLevel(s.ptr, level)
+}
+
+// SetEPS controls whether the surface produces Encapsulated PostScript.
+// An EPS document contains a single page and a bounding box fitted to the
+// drawing, so it can be placed into other documents.
+// Must be called before any drawing operations.
+func (s *PSSurface) SetEPS(eps bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceSetEPS(s.ptr, eps)
+}
+
+// GetEPS reports whether the surface produces Encapsulated PostScript.
+// Returns false if called on a closed surface.
+func (s *PSSurface) GetEPS() bool {
+	s.RLock()
+	defer s.RUnlock()
+	if s.ptr == nil {
+		return false
+	}
+	return psSurfaceGetEPS(s.ptr)
+}
+
+// DSCComment emits a Document Structuring Convention comment into the output.
+// The comment must begin with a percent sign, such as "%%Title: Report",
+// must not contain newlines, and must be at most 255 bytes long.
+//
+// Comments are placed in the header section by default. After DSCBeginSetup
+// they go in the Setup section, and after DSCBeginPageSetup they go in the
+// PageSetup section of the current page.
+func (s *PSSurface) DSCComment(comment string) {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceDSCComment(s.ptr, comment)
+}
+
+// DSCBeginSetup directs subsequent DSCComment calls to the Setup section of
+// the document. Call it at most once, after any header comments and before
+// any drawing.
+func (s *PSSurface) DSCBeginSetup() {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceDSCBeginSetup(s.ptr)
+}
+
+// DSCBeginPageSetup directs subsequent DSCComment calls to the PageSetup
+// section of the current page. Call it at most once per page, before any
+// drawing on that page.
+func (s *PSSurface) DSCBeginPageSetup() {
+	s.Lock()
+	defer s.Unlock()
+	if s.ptr == nil {
+		return
+	}
+	psSurfaceDSCBeginPageSetup(s.ptr)
+}
@@ -0,0 +1,97 @@
+// ABOUTME: CGO bindings for Cairo PostScript surface creation, EPS mode, level and DSC comment APIs.
+// ABOUTME: Wraps cairo_ps_surface_create and its stream variant, and the cairo_ps_* configuration functions.
+
+//go:build !nops
+
+package surface
+
+// #cgo pkg-config: cairo-ps
+// #include <cairo-ps.h>
+// #include <stdint.h>
+// #include <stdlib.h>
+//
+// extern cairo_status_t goSurfaceWriteStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_surface_t *_psSurfaceCreateForStream(uintptr_t h, double width, double height) {
+//     return cairo_ps_surface_create_for_stream((cairo_write_func_t)goSurfaceWriteStream, (void *)h, width, height);
+// }
+//
+// // _psGetLevelsList fills buf with supported PostScript level identifiers and
+// // returns the count. buf must have room for at least 16 entries.
+// static int _psGetLevelsList(cairo_ps_level_t *buf) {
+//     cairo_ps_level_t const *l;
+//     int n = 0;
+//     cairo_ps_get_levels(&l, &n);
+//     for (int i = 0; i < n; i++) { buf[i] = l[i]; }
+//     return n;
+// }
+import "C"
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+func psSurfaceCreate(filename string, widthPt, heightPt float64) SurfacePtr {
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	return SurfacePtr(C.cairo_ps_surface_create(cFilename, C.double(widthPt), C.double(heightPt)))
+}
+
+func psSurfaceCreateForStream(w *streamWriter, widthPt, heightPt float64) (SurfacePtr, status.Status) {
+	return surfaceCreateForStream(w, func(h C.uintptr_t) SurfacePtr {
+		return SurfacePtr(C._psSurfaceCreateForStream(h, C.double(widthPt), C.double(heightPt)))
+	})
+}
+
+func psSurfaceSetSize(ptr SurfacePtr, widthPt, heightPt float64) {
+	C.cairo_ps_surface_set_size(ptr, C.double(widthPt), C.double(heightPt))
+}
+
+func psSurfaceRestrictToLevel(ptr SurfacePtr, level PSLevel) {
+	C.cairo_ps_surface_restrict_to_level(ptr, C.cairo_ps_level_t(level))
+}
+
+func psSurfaceSetEPS(ptr SurfacePtr, eps bool) {
+	var cEPS C.cairo_bool_t
+	if eps {
+		cEPS = 1
+	}
+	C.cairo_ps_surface_set_eps(ptr, cEPS)
+}
+
+func psSurfaceGetEPS(ptr SurfacePtr) bool {
+	return C.cairo_ps_surface_get_eps(ptr) != 0
+}
+
+func psSurfaceDSCComment(ptr SurfacePtr, comment string) {
+	cComment := C.CString(comment)
+	defer C.free(unsafe.Pointer(cComment))
+	C.cairo_ps_surface_dsc_comment(ptr, cComment)
+}
+
+func psSurfaceDSCBeginSetup(ptr SurfacePtr) {
+	C.cairo_ps_surface_dsc_begin_setup(ptr)
+}
+
+func psSurfaceDSCBeginPageSetup(ptr SurfacePtr) {
+	C.cairo_ps_surface_dsc_begin_page_setup(ptr)
+}
+
+func psGetLevels() []PSLevel {
+	var buf [16]C.cairo_ps_level_t
+	count := int(C._psGetLevelsList(&buf[0]))
+	result := make([]PSLevel, count)
+	for i := range result {
+		result[i] = PSLevel(buf[i])
+	}
+	return result
+}
+
+func psLevelToString(level PSLevel) string {
+	cStr := C.cairo_ps_level_to_string(C.cairo_ps_level_t(level))
+	if cStr == nil {
+		return ""
+	}
+	return C.GoString(cStr)
+}
@@ -0,0 +1,163 @@
+// ABOUTME: Tests for PSSurface creation, EPS mode, language levels, and DSC comments.
+// ABOUTME: Uses t.TempDir() for test PostScript files to ensure automatic cleanup.
+
+//go:build !nops
+
+package surface
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewPSSurface verifies that a PostScript surface can be created and produces a file.
+func TestNewPSSurface(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "test.ps")
+
+	s, err := NewPSSurface(filename, 595, 842)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+
+	assert.Equal(t, status.Success, s.Status())
+	assert.False(t, s.GetEPS())
+
+	s.ShowPage()
+	s.SetSize(612, 792)
+	s.ShowPage()
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(filename) //nolint:gosec // filename is from t.TempDir()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "%!PS-Adobe-3.0\n"), "output should start with a PostScript header")
+	assert.Contains(t, string(data), "%%Pages: 2")
+}
+
+// TestNewPSSurfaceInvalidPath verifies that an invalid path returns an error.
+func TestNewPSSurfaceInvalidPath(t *testing.T) {
+	_, err := NewPSSurface("/nonexistent/dir/test.ps", 595, 842)
+	require.Error(t, err)
+}
+
+// TestPSLevels verifies that PSLevels returns both supported language levels.
+func TestPSLevels(t *testing.T) {
+	levels := PSLevels()
+	assert.Contains(t, levels, PSLevel2)
+	assert.Contains(t, levels, PSLevel3)
+}
+
+// TestPSLevelToString verifies that PSLevelToString returns human-readable strings.
+func TestPSLevelToString(t *testing.T) {
+	assert.Equal(t, "PS Level 2", PSLevelToString(PSLevel2))
+	assert.Equal(t, "PS Level 3", PSLevelToString(PSLevel3))
+	assert.Equal(t, "", PSLevelToString(PSLevel(99)))
+}
+
+// TestPSSurfaceRestrictToLevel verifies the language level is recorded in the output.
+func TestPSSurfaceRestrictToLevel(t *testing.T) {
+	tests := []struct {
+		level PSLevel
+		want  string
+	}{
+		{PSLevel2, "%%LanguageLevel: 2"},
+		{PSLevel3, "%%LanguageLevel: 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			s, err := NewPSSurfaceForWriter(&buf, 100, 100)
+			require.NoError(t, err)
+			s.RestrictToLevel(tt.level)
+			require.NoError(t, s.Close())
+
+			assert.Contains(t, buf.String(), tt.want)
+		})
+	}
+}
+
+// TestPSSurfaceEPS verifies that SetEPS produces an Encapsulated PostScript header.
+func TestPSSurfaceEPS(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "test.eps")
+
+	s, err := NewPSSurface(filename, 200, 100)
+	require.NoError(t, err)
+
+	s.SetEPS(true)
+	assert.True(t, s.GetEPS())
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(filename) //nolint:gosec // filename is from t.TempDir()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "%!PS-Adobe-3.0 EPSF-3.0"), "output should start with an EPS header")
+}
+
+// TestPSSurfaceDSCComments verifies header, Setup and PageSetup comments are
+// emitted in their sections.
+func TestPSSurfaceDSCComments(t *testing.T) {
+	var buf bytes.Buffer
+	s, err := NewPSSurfaceForWriter(&buf, 595, 842)
+	require.NoError(t, err)
+
+	s.DSCComment("%%Title: Test Document")
+	s.DSCBeginSetup()
+	s.DSCComment("%%IncludeFeature: *MediaColor White")
+	s.DSCBeginPageSetup()
+	s.DSCComment("%%IncludeFeature: *PageSize A4")
+	assert.Equal(t, status.Success, s.Status())
+	require.NoError(t, s.Close())
+
+	out := buf.String()
+	title := strings.Index(out, "%%Title: Test Document")
+	setup := strings.Index(out, "%%BeginSetup")
+	media := strings.Index(out, "%%IncludeFeature: *MediaColor White")
+	pageSetup := strings.Index(out, "%%BeginPageSetup")
+	pageSize := strings.Index(out, "%%IncludeFeature: *PageSize A4")
+
+	require.True(t, title >= 0 && setup >= 0 && media >= 0 && pageSetup >= 0 && pageSize >= 0, "all comments should be present")
+	assert.Less(t, title, setup)
+	assert.Less(t, setup, media)
+	assert.Less(t, media, pageSetup)
+	assert.Less(t, pageSetup, pageSize)
+}
+
+// TestPSSurfaceClosed verifies that methods on a closed surface are no-ops.
+func TestPSSurfaceClosed(t *testing.T) {
+	var buf bytes.Buffer
+	s, err := NewPSSurfaceForWriter(&buf, 100, 100)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// None of these should panic.
+	s.SetSize(200, 200)
+	s.ShowPage()
+	s.RestrictToLevel(PSLevel2)
+	s.SetEPS(true)
+	s.DSCComment("%%Title: Closed")
+	s.DSCBeginSetup()
+	s.DSCBeginPageSetup()
+	assert.False(t, s.GetEPS())
+}
+
+// TestNewPSSurfaceForWriterWriteError verifies writer failures are reported by Close.
+func TestNewPSSurfaceForWriterWriteError(t *testing.T) {
+	s, err := NewPSSurfaceForWriter(failingWriter{}, 100, 100)
+	require.NoError(t, err)
+
+	assert.Equal(t, status.WriteError, s.Close())
+}
+
+// TestNewPSSurfaceForWriterNil verifies a nil writer is rejected.
+func TestNewPSSurfaceForWriterNil(t *testing.T) {
+	s, err := NewPSSurfaceForWriter(nil, 100, 100)
+	assert.Nil(t, s)
+	assert.Equal(t, status.NullPointer, err)
+}
@@ -0,0 +1,125 @@
+// ABOUTME: RecordingSurface implementation that captures drawing operations for later replay.
+// ABOUTME: Recordings can be bounded or unbounded, used as pattern sources, or replayed onto any surface.
+
+package surface
+
+import "github.com/mikowitz/cairo/status"
+
+// RecordingSurface is a surface that records drawing operations instead of
+// rendering them. The recording can later be replayed onto any other surface,
+// at that surface's resolution, or used as the source of a surface pattern.
+//
+// This makes it possible to run layout and drawing code once and emit the
+// result to several backends, for example a PNG preview and a PDF document.
+//
+// A recording surface is either bounded, clipping recorded operations to a
+// rectangle given at creation, or unbounded, keeping everything drawn. Use
+// InkExtents to find the area actually covered by the recorded drawing.
+//
+// Example:
+//
+//	rec, err := surface.NewUnboundedRecordingSurface(surface.ContentColorAlpha)
+//	if err != nil {
+//		return err
+//	}
+//	defer rec.Close()
+//
+//	// ... draw to rec with a context ...
+//
+//	png, _ := surface.NewImageSurface(surface.FormatARGB32, 640, 480)
+//	defer png.Close()
+//	err = rec.Replay(png)
+type RecordingSurface struct {
+	*BaseSurface
+	content Content
+}
+
+// NewRecordingSurface creates a bounded recording surface. Drawing outside
+// the rectangle at (x, y) with the given width and height is clipped away,
+// as it would be on an image surface of that size. The extents are in
+// user-space units.
+//
+// Returns an error if Cairo cannot create the surface (e.g., invalid content).
+func NewRecordingSurface(content Content, x, y, width, height float64) (*RecordingSurface, error) {
+	return newRecordingSurface(recordingSurfaceCreate(content, x, y, width, height), content)
+}
+
+// NewUnboundedRecordingSurface creates a recording surface with no extents,
+// which records every drawing operation regardless of where it lands.
+//
+// Returns an error if Cairo cannot create the surface (e.g., invalid content).
+func NewUnboundedRecordingSurface(content Content) (*RecordingSurface, error) {
+	return newRecordingSurface(recordingSurfaceCreateUnbounded(content), content)
+}
+
+func newRecordingSurface(ptr SurfacePtr, content Content) (*RecordingSurface, error) {
+	st := surfaceStatus(ptr)
+	if st != status.Success {
+		surfaceClose(ptr)
+		return nil, st
+	}
+	return &RecordingSurface{BaseSurface: newBaseSurface(ptr), content: content}, nil
+}
+
+// GetContent returns the content type the surface was created with.
+func (s *RecordingSurface) GetContent() Content {
+	return s.content
+}
+
+// GetExtents returns the extents the surface was created with. bounded is
+// false for an unbounded recording surface, in which case the other values
+// are zero. Returns all zero values if called on a closed surface.
+func (s *RecordingSurface) GetExtents() (x, y, width, height float64, bounded bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.ptr == nil {
+		return 0, 0, 0, 0, false
+	}
+	return recordingSurfaceGetExtents(s.ptr)
+}
+
+// InkExtents returns the bounding box of everything drawn on the surface so
+// far, in user-space units. The box includes the effect of line widths and
+// anti-aliasing, and is empty (all zeros) if nothing has been drawn.
+//
+// If the surface has been closed, InkExtents returns (0, 0, 0, 0).
+func (s *RecordingSurface) InkExtents() (x, y, width, height float64) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.ptr == nil {
+		return 0, 0, 0, 0
+	}
+	return recordingSurfaceInkExtents(s.ptr)
+}
+
+// Replay paints the recorded drawing operations onto target, with the
+// recording's origin at the target's origin. Vector targets such as PDF and
+// SVG receive the operations as vector drawing; raster targets render them
+// at their own resolution.
+//
+// To place, scale or repeat the recording instead, create a surface pattern
+// from it and use it as the source of a context drawing on the target.
+//
+// Returns status.NullPointer if either surface is closed, or the Cairo error
+// if replaying fails.
+func (s *RecordingSurface) Replay(target Surface) error {
+	if target == nil {
+		return status.NullPointer
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	dst := target.Ptr()
+	if s.ptr == nil || dst == nil {
+		return status.NullPointer
+	}
+
+	st := recordingSurfaceReplay(s.ptr, dst)
+	if st != status.Success {
+		return st
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// ABOUTME: CGO bindings for Cairo recording surface creation, extents queries, and replay.
+// ABOUTME: Replay paints the recording through a temporary cairo_t owned entirely by C.
+
+package surface
+
+// #cgo pkg-config: cairo
+// #include <cairo.h>
+//
+// // _recordingSurfaceReplay paints src onto dst at the origin and returns the
+// // resulting context status.
+// static cairo_status_t _recordingSurfaceReplay(cairo_surface_t *src, cairo_surface_t *dst) {
+//     cairo_t *cr = cairo_create(dst);
+//     cairo_set_source_surface(cr, src, 0, 0);
+//     cairo_paint(cr);
+//     cairo_status_t st = cairo_status(cr);
+//     cairo_destroy(cr);
+//     return st;
+// }
+import "C"
+
+import "github.com/mikowitz/cairo/status"
+
+func recordingSurfaceCreate(content Content, x, y, width, height float64) SurfacePtr {
+	rect := C.cairo_rectangle_t{
+		x:      C.double(x),
+		y:      C.double(y),
+		width:  C.double(width),
+		height: C.double(height),
+	}
+	return SurfacePtr(C.cairo_recording_surface_create(C.cairo_content_t(content), &rect))
+}
+
+func recordingSurfaceCreateUnbounded(content Content) SurfacePtr {
+	return SurfacePtr(C.cairo_recording_surface_create(C.cairo_content_t(content), nil))
+}
+
+func recordingSurfaceGetExtents(ptr SurfacePtr) (x, y, width, height float64, bounded bool) {
+	var rect C.cairo_rectangle_t
+	if C.cairo_recording_surface_get_extents(ptr, &rect) == 0 {
+		return 0, 0, 0, 0, false
+	}
+	return float64(rect.x), float64(rect.y), float64(rect.width), float64(rect.height), true
+}
+
+func recordingSurfaceInkExtents(ptr SurfacePtr) (x, y, width, height float64) {
+	var cx, cy, cw, ch C.double
+	C.cairo_recording_surface_ink_extents(ptr, &cx, &cy, &cw, &ch)
+	return float64(cx), float64(cy), float64(cw), float64(ch)
+}
+
+func recordingSurfaceReplay(src, dst SurfacePtr) status.Status {
+	return status.Status(C._recordingSurfaceReplay(src, dst))
+}
@@ -0,0 +1,110 @@
+// ABOUTME: Tests for RecordingSurface creation, extents queries, and replay onto other surfaces.
+// ABOUTME: Drawing-dependent behavior is covered by the root package tests, which can use a Context.
+
+package surface
+
+import (
+	"testing"
+
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewRecordingSurface verifies a bounded recording surface reports its extents.
+func TestNewRecordingSurface(t *testing.T) {
+	s, err := NewRecordingSurface(ContentColorAlpha, 10, 20, 300, 200)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	assert.Equal(t, status.Success, s.Status())
+	assert.Equal(t, ContentColorAlpha, s.GetContent())
+
+	x, y, w, h, bounded := s.GetExtents()
+	assert.True(t, bounded)
+	assert.Equal(t, []float64{10, 20, 300, 200}, []float64{x, y, w, h})
+}
+
+// TestNewUnboundedRecordingSurface verifies an unbounded surface reports no extents.
+func TestNewUnboundedRecordingSurface(t *testing.T) {
+	for _, content := range []Content{ContentColor, ContentAlpha, ContentColorAlpha} {
+		t.Run(content.String(), func(t *testing.T) {
+			s, err := NewUnboundedRecordingSurface(content)
+			require.NoError(t, err)
+			defer func() {
+				_ = s.Close()
+			}()
+
+			assert.Equal(t, content, s.GetContent())
+			_, _, _, _, bounded := s.GetExtents()
+			assert.False(t, bounded)
+		})
+	}
+}
+
+// TestRecordingSurfaceInkExtentsEmpty verifies an empty recording has empty ink extents.
+func TestRecordingSurfaceInkExtentsEmpty(t *testing.T) {
+	s, err := NewUnboundedRecordingSurface(ContentColorAlpha)
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	x, y, w, h := s.InkExtents()
+	assert.Equal(t, []float64{0, 0, 0, 0}, []float64{x, y, w, h})
+}
+
+// TestRecordingSurfaceReplayEmpty verifies replaying an empty recording leaves
+// the target untouched.
+func TestRecordingSurfaceReplayEmpty(t *testing.T) {
+	rec, err := NewUnboundedRecordingSurface(ContentColorAlpha)
+	require.NoError(t, err)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	target := createTestSurface(t)
+	defer func() {
+		_ = target.Close()
+	}()
+
+	require.NoError(t, rec.Replay(target))
+
+	data, err := target.GetData()
+	require.NoError(t, err)
+	for i, b := range data {
+		if b != 0 {
+			t.Fatalf("byte %d should be zero after replaying an empty recording, got %d", i, b)
+		}
+	}
+}
+
+// TestRecordingSurfaceClosed verifies methods on a closed surface are safe.
+func TestRecordingSurfaceClosed(t *testing.T) {
+	rec, err := NewRecordingSurface(ContentColorAlpha, 0, 0, 10, 10)
+	require.NoError(t, err)
+	require.NoError(t, rec.Close())
+
+	target := createTestSurface(t)
+	defer func() {
+		_ = target.Close()
+	}()
+
+	assert.Equal(t, status.NullPointer, rec.Replay(target))
+	_, _, _, _, bounded := rec.GetExtents()
+	assert.False(t, bounded)
+	x, y, w, h := rec.InkExtents()
+	assert.Equal(t, []float64{0, 0, 0, 0}, []float64{x, y, w, h})
+
+	open, err := NewUnboundedRecordingSurface(ContentColorAlpha)
+	require.NoError(t, err)
+	defer func() {
+		_ = open.Close()
+	}()
+	require.NoError(t, target.Close())
+	assert.Equal(t, status.NullPointer, open.Replay(target))
+	assert.Equal(t, status.NullPointer, open.Replay(nil))
+}
@@ -0,0 +1,177 @@
+// ABOUTME: Script device and ScriptSurface, which record Cairo calls as a cairo-script (.cs) trace.
+// ABOUTME: Traces go to a file or io.Writer, and can wrap an existing surface to trace while drawing.
+
+//go:build !noscript
+
+package surface
+
+import (
+	"io"
+
+	"github.com/mikowitz/cairo/device"
+	"github.com/mikowitz/cairo/status"
+)
+
+// ScriptMode specifies how a Script encodes its trace.
+// These values correspond directly to Cairo's cairo_script_mode_t enum.
+//
+//go:generate sh -c "stringer -type=ScriptMode -tags '!noscript' && awk '/^package /{print \"//go:build !noscript\"; print \"\"; print; next}1' scriptmode_string.go > /tmp/_script_tmp.go && mv /tmp/_script_tmp.go scriptmode_string.go"
+type ScriptMode int
+
+const (
+	// ScriptModeASCII writes the trace as readable text.
+	ScriptModeASCII ScriptMode = iota
+	// ScriptModeBinary writes the trace with binary data, such as image
+	// data, left unencoded, which is smaller but not readable.
+	ScriptModeBinary
+)
+
+// Script is a device that records the Cairo calls made on its surfaces as a
+// cairo-script trace. Replay a trace with Cairo's csi-replay tool, or trace a
+// whole program with cairo-trace.
+//
+// Create surfaces that record into the script with NewScriptSurface and
+// NewScriptSurfaceForTarget. Close the surfaces and their contexts before
+// closing the Script, since Close finishes the trace.
+type Script struct {
+	*device.Device
+	stream *streamWriter
+}
+
+// NewScript creates a script device writing its trace to filename.
+//
+// Returns the Cairo error if the file cannot be created.
+func NewScript(filename string) (*Script, error) {
+	ptr := scriptCreate(filename)
+	if st := scriptStatus(ptr); st != status.Success {
+		scriptClose(ptr)
+		return nil, st
+	}
+	return &Script{Device: device.DeviceFromC(ptr)}, nil
+}
+
+// NewScriptForWriter creates a script device writing its trace to w, such
+// as a bytes.Buffer or a network connection.
+//
+// Close returns status.WriteError if any write to w failed.
+//
+// Returns status.NullPointer if w is nil, or the Cairo error if the device
+// cannot be created.
+func NewScriptForWriter(w io.Writer) (*Script, error) {
+	if w == nil {
+		return nil, status.NullPointer
+	}
+
+	stream := newStreamWriter(w)
+	ptr, st := scriptCreateForStream(stream)
+	if st != status.Success {
+		return nil, st
+	}
+	return &Script{Device: device.DeviceFromC(ptr), stream: stream}, nil
+}
+
+// Close finishes the trace and releases the script. For scripts created with
+// NewScriptForWriter, the remaining output is written before Close returns,
+// and status.WriteError is returned if any write failed.
+func (s *Script) Close() error {
+	s.Finish()
+	if err := s.Device.Close(); err != nil {
+		return err
+	}
+	if s.stream != nil {
+		return s.stream.status()
+	}
+	return nil
+}
+
+// SetMode sets how the rest of the trace is encoded.
+func (s *Script) SetMode(mode ScriptMode) {
+	if ptr := s.Ptr(); ptr != nil {
+		scriptSetMode(ptr, mode)
+	}
+}
+
+// GetMode returns how the trace is encoded.
+func (s *Script) GetMode() ScriptMode {
+	ptr := s.Ptr()
+	if ptr == nil {
+		return ScriptModeASCII
+	}
+	return scriptGetMode(ptr)
+}
+
+// WriteComment writes comment into the trace, for example to mark where a
+// frame or request begins. Each line of comment becomes a separate comment
+// line.
+func (s *Script) WriteComment(comment string) {
+	if ptr := s.Ptr(); ptr != nil {
+		scriptWriteComment(ptr, comment)
+	}
+}
+
+// WriteRecordingSurface writes the operations recorded by rec into the
+// trace, as if they had been drawn on a ScriptSurface.
+//
+// Returns status.NullPointer if the script or rec is nil or closed, or the
+// Cairo error if the recording cannot be converted.
+func (s *Script) WriteRecordingSurface(rec *RecordingSurface) error {
+	ptr := s.Ptr()
+	if ptr == nil || rec == nil {
+		return status.NullPointer
+	}
+
+	rec.RLock()
+	defer rec.RUnlock()
+
+	if rec.ptr == nil {
+		return status.NullPointer
+	}
+	if st := scriptFromRecordingSurface(ptr, rec.ptr); st != status.Success {
+		return st
+	}
+	return nil
+}
+
+// ScriptSurface is a surface whose drawing is recorded into a Script.
+// Dimensions are in device units, like those of an ImageSurface.
+type ScriptSurface struct {
+	*BaseSurface
+}
+
+// NewScriptSurface creates a surface of the given content and size that
+// records into script. Nothing is rendered; the drawing exists only in the
+// trace.
+//
+// Returns status.NullPointer if script is nil or closed, or the Cairo error
+// if the surface cannot be created.
+func NewScriptSurface(script *Script, content Content, width, height float64) (*ScriptSurface, error) {
+	if script == nil || script.Ptr() == nil {
+		return nil, status.NullPointer
+	}
+
+	ptr := scriptSurfaceCreate(script.Ptr(), content, width, height)
+	if st := surfaceStatus(ptr); st != status.Success {
+		surfaceClose(ptr)
+		return nil, st
+	}
+	return &ScriptSurface{BaseSurface: newBaseSurface(ptr)}, nil
+}
+
+// NewScriptSurfaceForTarget creates a surface that draws onto target and
+// records every call into script, so the trace captures exactly what was
+// rendered. Draw on the returned surface instead of target.
+//
+// Returns status.NullPointer if script or target is nil or closed, or the
+// Cairo error if the surface cannot be created.
+func NewScriptSurfaceForTarget(script *Script, target Surface) (*ScriptSurface, error) {
+	if script == nil || script.Ptr() == nil || target == nil || target.Ptr() == nil {
+		return nil, status.NullPointer
+	}
+
+	ptr := scriptSurfaceCreateForTarget(script.Ptr(), target.Ptr())
+	if st := surfaceStatus(ptr); st != status.Success {
+		surfaceClose(ptr)
+		return nil, st
+	}
+	return &ScriptSurface{BaseSurface: newBaseSurface(ptr)}, nil
+}
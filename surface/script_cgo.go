@@ -0,0 +1,98 @@
+// ABOUTME: CGO bindings for cairo-script devices and surfaces.
+// ABOUTME: Wraps cairo_script_create and its stream variant, script surfaces, modes and comments.
+
+//go:build !noscript
+
+package surface
+
+// #cgo pkg-config: cairo-script
+// #include <cairo-script.h>
+// #include <stdint.h>
+// #include <stdlib.h>
+//
+// extern void goSurfaceReleaseHandle(void *data);
+// extern cairo_status_t goSurfaceWriteStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_user_data_key_t _goScriptHandleKey;
+//
+// static cairo_device_t *_scriptCreateForStream(uintptr_t h) {
+//     return cairo_script_create_for_stream((cairo_write_func_t)goSurfaceWriteStream, (void *)h);
+// }
+//
+// // _scriptAttachHandle stores a Go handle on the device. Cairo calls
+// // goSurfaceReleaseHandle with it when the device is finally destroyed,
+// // which may be later than Close if a surface still references it.
+// static cairo_status_t _scriptAttachHandle(cairo_device_t *d, uintptr_t h) {
+//     return cairo_device_set_user_data(d, &_goScriptHandleKey, (void *)h, goSurfaceReleaseHandle);
+// }
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+func scriptCreate(filename string) unsafe.Pointer {
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	return unsafe.Pointer(C.cairo_script_create(cFilename))
+}
+
+// scriptCreateForStream creates a script device that writes its trace to
+// w. The handle stays attached to the device until Cairo destroys it, since
+// the trace is written until the device is finished.
+func scriptCreateForStream(w *streamWriter) (unsafe.Pointer, status.Status) {
+	h := cgo.NewHandle(w)
+	ptr := C._scriptCreateForStream(C.uintptr_t(h))
+	if st := status.Status(C.cairo_device_status(ptr)); st != status.Success {
+		C.cairo_device_destroy(ptr)
+		h.Delete()
+		return nil, st
+	}
+
+	if st := status.Status(C._scriptAttachHandle(ptr, C.uintptr_t(h))); st != status.Success {
+		C.cairo_device_destroy(ptr)
+		h.Delete()
+		return nil, st
+	}
+
+	return unsafe.Pointer(ptr), status.Success
+}
+
+func scriptStatus(ptr unsafe.Pointer) status.Status {
+	return status.Status(C.cairo_device_status((*C.cairo_device_t)(ptr)))
+}
+
+func scriptClose(ptr unsafe.Pointer) {
+	C.cairo_device_destroy((*C.cairo_device_t)(ptr))
+}
+
+func scriptSetMode(ptr unsafe.Pointer, mode ScriptMode) {
+	C.cairo_script_set_mode((*C.cairo_device_t)(ptr), C.cairo_script_mode_t(mode))
+}
+
+func scriptGetMode(ptr unsafe.Pointer) ScriptMode {
+	return ScriptMode(C.cairo_script_get_mode((*C.cairo_device_t)(ptr)))
+}
+
+func scriptWriteComment(ptr unsafe.Pointer, comment string) {
+	cComment := C.CString(comment)
+	defer C.free(unsafe.Pointer(cComment))
+	C.cairo_script_write_comment((*C.cairo_device_t)(ptr), cComment, C.int(len(comment)))
+}
+
+func scriptFromRecordingSurface(ptr unsafe.Pointer, rec SurfacePtr) status.Status {
+	return status.Status(C.cairo_script_from_recording_surface((*C.cairo_device_t)(ptr), rec))
+}
+
+func scriptSurfaceCreate(ptr unsafe.Pointer, content Content, width, height float64) SurfacePtr {
+	return SurfacePtr(C.cairo_script_surface_create(
+		(*C.cairo_device_t)(ptr), C.cairo_content_t(content), C.double(width), C.double(height),
+	))
+}
+
+func scriptSurfaceCreateForTarget(ptr unsafe.Pointer, target SurfacePtr) SurfacePtr {
+	return SurfacePtr(C.cairo_script_surface_create_for_target((*C.cairo_device_t)(ptr), target))
+}
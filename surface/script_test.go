@@ -0,0 +1,169 @@
+// ABOUTME: Tests for Script devices and ScriptSurface: trace files and writers, modes, comments and targets.
+// ABOUTME: Drawing into traces is covered by the root package tests, which can use a Context.
+
+//go:build !noscript
+
+package surface
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikowitz/cairo/device"
+	"github.com/mikowitz/cairo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewScript verifies that a script writes a trace file.
+func TestNewScript(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "trace.cs")
+
+	script, err := NewScript(filename)
+	require.NoError(t, err)
+	assert.Equal(t, status.Success, script.Status())
+	assert.Equal(t, device.DeviceTypeScript, script.GetType())
+
+	surf, err := NewScriptSurface(script, ContentColorAlpha, 100, 50)
+	require.NoError(t, err)
+	assert.Equal(t, status.Success, surf.Status())
+	require.NoError(t, surf.Close())
+	require.NoError(t, script.Close())
+	require.NoError(t, script.Close(), "closing twice should be safe")
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%!CairoScript")), "trace should start with the cairo-script header")
+}
+
+// TestNewScriptForWriter verifies that a script writes its trace to an
+// io.Writer, including comments.
+func TestNewScriptForWriter(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := NewScriptForWriter(&buf)
+	require.NoError(t, err)
+
+	script.WriteComment("frame 1")
+	surf, err := NewScriptSurface(script, ContentColor, 64, 64)
+	require.NoError(t, err)
+	require.NoError(t, surf.Close())
+	require.NoError(t, script.Close())
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%!CairoScript")))
+	assert.Contains(t, buf.String(), "frame 1")
+
+	_, err = NewScriptForWriter(nil)
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestScriptWriteError verifies that write failures are reported by Close.
+func TestScriptWriteError(t *testing.T) {
+	script, err := NewScriptForWriter(failingWriter{})
+	require.NoError(t, err)
+	script.WriteComment("lost")
+
+	assert.Equal(t, status.WriteError, script.Close())
+}
+
+// TestScriptMode verifies setting and getting the trace encoding.
+func TestScriptMode(t *testing.T) {
+	script, err := NewScriptForWriter(&bytes.Buffer{})
+	require.NoError(t, err)
+	defer func() {
+		_ = script.Close()
+	}()
+
+	assert.Equal(t, ScriptModeASCII, script.GetMode())
+	script.SetMode(ScriptModeBinary)
+	assert.Equal(t, ScriptModeBinary, script.GetMode())
+	assert.Equal(t, "ScriptModeBinary", ScriptModeBinary.String())
+}
+
+// TestScriptSurfaceForTarget verifies wrapping an existing surface and that
+// script surfaces report their device.
+func TestScriptSurfaceForTarget(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := NewScriptForWriter(&buf)
+	require.NoError(t, err)
+	defer func() {
+		_ = script.Close()
+	}()
+
+	target, err := NewImageSurface(FormatARGB32, 40, 30)
+	require.NoError(t, err)
+	defer func() {
+		_ = target.Close()
+	}()
+
+	surf, err := NewScriptSurfaceForTarget(script, target)
+	require.NoError(t, err)
+	defer func() {
+		_ = surf.Close()
+	}()
+	assert.Equal(t, status.Success, surf.Status())
+
+	dev, err := surf.GetDevice()
+	require.NoError(t, err)
+	require.NotNil(t, dev)
+	defer func() {
+		_ = dev.Close()
+	}()
+	assert.Equal(t, script.Ptr(), dev.Ptr(), "the surface should belong to the script")
+	assert.Equal(t, device.DeviceTypeScript, dev.GetType())
+
+	dev, err = target.GetDevice()
+	require.NoError(t, err)
+	assert.Nil(t, dev, "image surfaces have no device")
+}
+
+// TestScriptWriteRecordingSurface verifies converting a recording into the
+// trace.
+func TestScriptWriteRecordingSurface(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := NewScriptForWriter(&buf)
+	require.NoError(t, err)
+
+	rec, err := NewRecordingSurface(ContentColorAlpha, 0, 0, 20, 20)
+	require.NoError(t, err)
+	defer func() {
+		_ = rec.Close()
+	}()
+
+	before := buf.Len()
+	require.NoError(t, script.WriteRecordingSurface(rec))
+	require.NoError(t, script.Close())
+	assert.Greater(t, buf.Len(), before)
+
+	assert.Equal(t, status.NullPointer, script.WriteRecordingSurface(rec), "closed script")
+}
+
+// TestScriptClosedState verifies behavior with nil and closed scripts and
+// targets.
+func TestScriptClosedState(t *testing.T) {
+	script, err := NewScriptForWriter(&bytes.Buffer{})
+	require.NoError(t, err)
+
+	target, err := NewImageSurface(FormatARGB32, 10, 10)
+	require.NoError(t, err)
+	require.NoError(t, target.Close())
+
+	_, err = NewScriptSurfaceForTarget(script, target)
+	assert.Equal(t, status.NullPointer, err)
+	_, err = NewScriptSurfaceForTarget(script, nil)
+	assert.Equal(t, status.NullPointer, err)
+	_, err = NewScriptSurface(nil, ContentColor, 10, 10)
+	assert.Equal(t, status.NullPointer, err)
+	assert.Equal(t, status.NullPointer, script.WriteRecordingSurface(nil))
+
+	_, err = target.GetDevice()
+	assert.Equal(t, status.NullPointer, err)
+
+	require.NoError(t, script.Close())
+	_, err = NewScriptSurface(script, ContentColor, 10, 10)
+	assert.Equal(t, status.NullPointer, err)
+	assert.Equal(t, ScriptModeASCII, script.GetMode())
+	script.SetMode(ScriptModeBinary)
+	script.WriteComment("ignored")
+}
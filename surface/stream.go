@@ -0,0 +1,62 @@
+// ABOUTME: Bridges Cairo's *_for_stream write callbacks to Go io.Writers.
+// ABOUTME: Records the first write error so stream-backed surfaces can report it from Close.
+
+package surface
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/mikowitz/cairo/status"
+)
+
+// streamWriter forwards output from Cairo to an io.Writer. Once a write
+// fails, Cairo puts the surface into an error state and later writes are
+// refused, so only the first error is kept.
+type streamWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+func newStreamWriter(w io.Writer) *streamWriter {
+	return &streamWriter{w: w}
+}
+
+// write writes all of p to the underlying writer and reports whether it
+// succeeded. A panic in the writer is recovered and recorded as the error,
+// since it would otherwise unwind through Cairo's C frames mid-write.
+func (s *streamWriter) write(p []byte) (ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.err = fmt.Errorf("stream writer panicked: %v", r)
+			ok = false
+		}
+	}()
+
+	n, err := s.w.Write(p)
+	if err == nil && n < len(p) {
+		err = io.ErrShortWrite
+	}
+	s.err = err
+	return err == nil
+}
+
+// status returns status.WriteError if any write has failed, or nil.
+func (s *streamWriter) status() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return status.WriteError
+	}
+	return nil
+}
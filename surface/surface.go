@@ -1,9 +1,14 @@
 package surface
 
 import (
+	"io"
 	"runtime"
 	"sync"
+	"unsafe"
 
+	"github.com/mikowitz/cairo/device"
+	"github.com/mikowitz/cairo/font"
+	"github.com/mikowitz/cairo/region"
 	"github.com/mikowitz/cairo/status"
 )
 
@@ -31,6 +36,40 @@ func newBaseSurface(ptr SurfacePtr) *BaseSurface {
 	return b
 }
 
+// SurfaceFromC wraps a C cairo_surface_t pointer and returns the matching Go
+// Surface implementation based on the surface's type.
+//
+// This function is primarily used internally when retrieving surfaces from
+// Cairo C API functions (e.g., cairo_get_group_target). Image surfaces are
+// returned as *ImageSurface and recording surfaces as *RecordingSurface;
+// other surface types are returned as *BaseSurface.
+//
+// The returned Surface takes ownership of one reference to the C surface and
+// releases it when Close() is called or when the finalizer runs, so callers
+// must reference a borrowed pointer before wrapping it.
+func SurfaceFromC(uPtr unsafe.Pointer) Surface {
+	ptr := SurfacePtr(uPtr)
+	base := newBaseSurface(ptr)
+
+	switch {
+	case surfaceIsImage(ptr):
+		return &ImageSurface{
+			BaseSurface: base,
+			format:      imageSurfaceGetFormat(ptr),
+			width:       imageSurfaceGetWidth(ptr),
+			height:      imageSurfaceGetHeight(ptr),
+			stride:      imageSurfaceGetStride(ptr),
+		}
+	case surfaceIsRecording(ptr):
+		return &RecordingSurface{
+			BaseSurface: base,
+			content:     surfaceGetContent(ptr),
+		}
+	default:
+		return base
+	}
+}
+
 // Ptr returns the underlying pointer for the surface.
 func (b *BaseSurface) Ptr() SurfacePtr {
 	return b.ptr
@@ -99,6 +138,81 @@ func (b *BaseSurface) MarkDirtyRectangle(x, y, width, height int) {
 	surfaceMarkDirtyRectangle(b.ptr, x, y, width, height)
 }
 
+// MarkDirtyRegion is like [BaseSurface.MarkDirtyRectangle] for each
+// rectangle of r, so that a region collected while drawing by other means can
+// be marked dirty in one call. Nil, closed or empty regions mark nothing.
+func (b *BaseSurface) MarkDirtyRegion(r *region.Region) {
+	if r == nil {
+		return
+	}
+	rects := r.Rectangles()
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.ptr == nil {
+		return
+	}
+	for _, rect := range rects {
+		surfaceMarkDirtyRectangle(b.ptr, rect.X, rect.Y, rect.Width, rect.Height)
+	}
+}
+
+// GetDevice returns the device the surface belongs to, such as the Script a
+// ScriptSurface records into. Image, PDF, SVG, PostScript and recording
+// surfaces have no device, and GetDevice returns nil for them.
+//
+// The returned Device must be closed when no longer needed; closing it does
+// not affect the surface.
+//
+// Returns status.NullPointer if the surface has been closed.
+func (b *BaseSurface) GetDevice() (*device.Device, error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.ptr == nil {
+		return nil, status.NullPointer
+	}
+	ptr := surfaceGetDevice(b.ptr)
+	if ptr == nil {
+		return nil, nil
+	}
+	return device.DeviceFromC(ptr), nil
+}
+
+// HasShowTextGlyphs reports whether the surface uses the text and clusters
+// passed to Context.ShowTextGlyphs, as PDF surfaces do to keep text
+// searchable and copyable. On other surfaces ShowTextGlyphs still works but
+// only the glyphs are used.
+//
+// Returns false if the surface has been closed.
+func (b *BaseSurface) HasShowTextGlyphs() bool {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.ptr == nil {
+		return false
+	}
+	return surfaceHasShowTextGlyphs(b.ptr)
+}
+
+// GetFontOptions returns the default font options for text drawn on the
+// surface. The result reflects the surface's target device, such as hinting
+// disabled for vector surfaces like PDF and SVG. Font options set on a
+// Context are merged with these. The returned Options should be closed by
+// the caller.
+//
+// Returns status.NullPointer if the surface has been closed.
+func (b *BaseSurface) GetFontOptions() (*font.Options, error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.ptr == nil {
+		return nil, status.NullPointer
+	}
+	return surfaceGetFontOptions(b.ptr)
+}
+
 // WriteToPNG writes the contents of the surface to a new PNG file at the specified filepath.
 //
 // The surface should be flushed with Flush() before calling WriteToPNG to ensure all
@@ -133,6 +247,57 @@ func (b *BaseSurface) WriteToPNG(filepath string) error {
 	return surfaceWriteToPNG(b.ptr, filepath)
 }
 
+// WriteToPNGWriter writes the contents of the surface as PNG data to w, for
+// example an http.ResponseWriter or a bytes.Buffer.
+//
+// As with WriteToPNG, call Flush first if the surface has been modified
+// outside of Cairo.
+//
+// Returns status.NullPointer if the surface is closed or w is nil,
+// status.WriteError if w returns an error or writes fewer bytes than given,
+// or the Cairo error if the surface cannot be encoded.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	if err := surf.WriteToPNGWriter(&buf); err != nil {
+//		return err
+//	}
+func (b *BaseSurface) WriteToPNGWriter(w io.Writer) error {
+	if w == nil {
+		return status.NullPointer
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.ptr == nil {
+		return status.NullPointer
+	}
+	return surfaceWriteToPNGStream(b.ptr, newStreamWriter(w))
+}
+
+// closeStream closes a surface created for an io.Writer. The surface is
+// finished first, so the rest of the document is written before Close
+// returns, and any error from the writer is reported as status.WriteError.
+// Surfaces not backed by a writer (w is nil) are closed as usual.
+func (b *BaseSurface) closeStream(w *streamWriter) error {
+	if w == nil {
+		return b.close()
+	}
+
+	b.Lock()
+	if b.ptr != nil {
+		surfaceFinish(b.ptr)
+	}
+	b.Unlock()
+
+	if err := b.close(); err != nil {
+		return err
+	}
+	return w.status()
+}
+
 func (b *BaseSurface) close() error {
 	b.Lock()
 	defer b.Unlock()
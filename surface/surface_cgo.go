@@ -2,12 +2,31 @@ package surface
 
 // #cgo pkg-config: cairo
 // #include <cairo.h>
+// #include <stdint.h>
 // #include <stdlib.h>
+//
+// extern void goSurfaceReleaseHandle(void *data);
+// extern cairo_status_t goSurfaceWriteStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_user_data_key_t _goHandleKey;
+//
+// // _surfaceAttachHandle stores a Go handle on the surface. Cairo calls
+// // goSurfaceReleaseHandle with it when the surface is finally destroyed,
+// // which may be later than Close if a context still references the surface.
+// static cairo_status_t _surfaceAttachHandle(cairo_surface_t *s, uintptr_t h) {
+//     return cairo_surface_set_user_data(s, &_goHandleKey, (void *)h, goSurfaceReleaseHandle);
+// }
+//
+// static cairo_status_t _surfaceWriteToPNGStream(cairo_surface_t *s, uintptr_t h) {
+//     return cairo_surface_write_to_png_stream(s, (cairo_write_func_t)goSurfaceWriteStream, (void *)h);
+// }
 import "C"
 
 import (
+	"runtime/cgo"
 	"unsafe"
 
+	"github.com/mikowitz/cairo/font"
 	"github.com/mikowitz/cairo/status"
 )
 
@@ -17,6 +36,18 @@ func surfaceClose(ptr SurfacePtr) {
 	C.cairo_surface_destroy(ptr)
 }
 
+func surfaceIsImage(ptr SurfacePtr) bool {
+	return C.cairo_surface_get_type(ptr) == C.CAIRO_SURFACE_TYPE_IMAGE
+}
+
+func surfaceIsRecording(ptr SurfacePtr) bool {
+	return C.cairo_surface_get_type(ptr) == C.CAIRO_SURFACE_TYPE_RECORDING
+}
+
+func surfaceGetContent(ptr SurfacePtr) Content {
+	return Content(C.cairo_surface_get_content(ptr))
+}
+
 func surfaceStatus(ptr SurfacePtr) status.Status {
 	return status.Status(C.cairo_surface_status(ptr))
 }
@@ -47,10 +78,121 @@ func imageSurfaceCreate(format Format, width, height int) SurfacePtr {
 	)
 }
 
+func imageSurfaceCreateForData(data []byte, format Format, width, height, stride int) (SurfacePtr, status.Status) {
+	pd := &pinnedData{}
+	pd.pinner.Pin(&data[0])
+
+	ptr := SurfacePtr(C.cairo_image_surface_create_for_data(
+		(*C.uchar)(unsafe.Pointer(&data[0])),
+		C.cairo_format_t(format),
+		C.int(width),
+		C.int(height),
+		C.int(stride),
+	))
+	if st := surfaceStatus(ptr); st != status.Success {
+		surfaceClose(ptr)
+		pd.release()
+		return nil, st
+	}
+
+	if st := surfaceAttachHandle(ptr, cgo.NewHandle(pd)); st != status.Success {
+		return nil, st
+	}
+
+	return ptr, status.Success
+}
+
+// surfaceAttachHandle ties h to the lifetime of the C surface. The handle is
+// released when Cairo destroys the surface. If it fails to attach, the
+// surface is destroyed first, so any output it flushes still reaches the
+// handle, and then the handle is released.
+func surfaceAttachHandle(ptr SurfacePtr, h cgo.Handle) status.Status {
+	st := status.Status(C._surfaceAttachHandle(ptr, C.uintptr_t(h)))
+	if st != status.Success {
+		surfaceClose(ptr)
+		releaseHandle(h)
+	}
+	return st
+}
+
+// surfaceCreateForStream creates a surface that writes its output to w.
+// create calls the backend's *_for_stream constructor with the closure
+// argument h; the handle stays attached to the surface until Cairo
+// destroys it, since backends write until the surface is finished.
+func surfaceCreateForStream(w *streamWriter, create func(h C.uintptr_t) SurfacePtr) (SurfacePtr, status.Status) {
+	h := cgo.NewHandle(w)
+	ptr := create(C.uintptr_t(h))
+	if st := surfaceStatus(ptr); st != status.Success {
+		surfaceClose(ptr)
+		h.Delete()
+		return nil, st
+	}
+
+	if st := surfaceAttachHandle(ptr, h); st != status.Success {
+		return nil, st
+	}
+
+	return ptr, status.Success
+}
+
+// surfaceGetDevice returns a new reference to the device of the surface, or
+// nil if it has none.
+func surfaceGetDevice(ptr SurfacePtr) unsafe.Pointer {
+	dev := C.cairo_surface_get_device(ptr)
+	if dev == nil {
+		return nil
+	}
+	return unsafe.Pointer(C.cairo_device_reference(dev))
+}
+
+func surfaceFinish(ptr SurfacePtr) {
+	C.cairo_surface_finish(ptr)
+}
+
+func imageSurfaceGetData(ptr SurfacePtr) []byte {
+	data := C.cairo_image_surface_get_data(ptr)
+	if data == nil {
+		return nil
+	}
+	stride := int(C.cairo_image_surface_get_stride(ptr))
+	height := int(C.cairo_image_surface_get_height(ptr))
+	return unsafe.Slice((*byte)(unsafe.Pointer(data)), stride*height)
+}
+
+func imageSurfaceGetStride(ptr SurfacePtr) int {
+	return int(C.cairo_image_surface_get_stride(ptr))
+}
+
+func imageSurfaceGetFormat(ptr SurfacePtr) Format {
+	return Format(C.cairo_image_surface_get_format(ptr))
+}
+
+func imageSurfaceGetWidth(ptr SurfacePtr) int {
+	return int(C.cairo_image_surface_get_width(ptr))
+}
+
+func imageSurfaceGetHeight(ptr SurfacePtr) int {
+	return int(C.cairo_image_surface_get_height(ptr))
+}
+
 func surfaceShowPage(ptr SurfacePtr) {
 	C.cairo_surface_show_page(ptr)
 }
 
+func surfaceHasShowTextGlyphs(ptr SurfacePtr) bool {
+	return C.cairo_surface_has_show_text_glyphs(ptr) != 0
+}
+
+func surfaceGetFontOptions(ptr SurfacePtr) (*font.Options, error) {
+	options := C.cairo_font_options_create()
+	if st := status.Status(C.cairo_font_options_status(options)); st != status.Success {
+		C.cairo_font_options_destroy(options)
+		return nil, st
+	}
+	C.cairo_surface_get_font_options(ptr, options)
+	return font.OptionsFromC(unsafe.Pointer(options)), nil
+}
+
 func surfaceWriteToPNG(ptr SurfacePtr, filepath string) error {
 	cFilepath := C.CString(filepath)
 	defer C.free(unsafe.Pointer(cFilepath))
@@ -62,3 +204,16 @@ func surfaceWriteToPNG(ptr SurfacePtr, filepath string) error {
 	}
 	return s
 }
+
+// surfaceWriteToPNGStream writes the surface as PNG to w. Cairo only calls
+// back into w during this call, so the handle does not outlive it.
+func surfaceWriteToPNGStream(ptr SurfacePtr, w *streamWriter) error {
+	h := cgo.NewHandle(w)
+	defer h.Delete()
+
+	s := status.Status(C._surfaceWriteToPNGStream(ptr, C.uintptr_t(h)))
+	if s == status.Success {
+		return nil
+	}
+	return s
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/mikowitz/cairo/region"
 	"github.com/mikowitz/cairo/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -145,6 +146,32 @@ func TestBaseSurfaceMarkDirtyRectangle(t *testing.T) {
 	}
 }
 
+// TestBaseSurfaceMarkDirtyRegion verifies MarkDirtyRegion() with populated,
+// empty, closed and nil regions
+func TestBaseSurfaceMarkDirtyRegion(t *testing.T) {
+	s := createTestSurface(t)
+	defer func() {
+		err := s.Close()
+		require.NoError(t, err, "Surface should close without error")
+	}()
+
+	damage, err := region.NewRegionRectangles([]region.Rectangle{{X: 0, Y: 0, Width: 10, Height: 10}, {X: 50, Y: 50, Width: 20, Height: 5}})
+	require.NoError(t, err)
+	defer func() {
+		_ = damage.Close()
+	}()
+	s.MarkDirtyRegion(damage)
+	assert.Equal(t, status.Success, s.Status(), "Status should be Success after MarkDirtyRegion")
+
+	empty, err := region.NewRegion()
+	require.NoError(t, err)
+	s.MarkDirtyRegion(empty)
+	require.NoError(t, empty.Close())
+	s.MarkDirtyRegion(empty)
+	s.MarkDirtyRegion(nil)
+	assert.Equal(t, status.Success, s.Status(), "Empty, closed and nil regions should mark nothing")
+}
+
 // TestBaseSurfaceClosedState verifies operations on closed surface
 func TestBaseSurfaceClosedState(t *testing.T) {
 	s := createTestSurface(t)
@@ -172,6 +199,43 @@ func TestBaseSurfaceClosedState(t *testing.T) {
 	s.MarkDirtyRectangle(0, 0, 10, 10)
 	st = s.Status()
 	assert.NotEqual(t, status.InvalidStatus, st, "Status after MarkDirtyRectangle on closed surface should be valid")
+
+	// MarkDirtyRegion on closed surface should be safe (no panic)
+	damage, err := region.NewRegionRectangle(region.Rectangle{X: 0, Y: 0, Width: 10, Height: 10})
+	require.NoError(t, err)
+	defer func() {
+		_ = damage.Close()
+	}()
+	s.MarkDirtyRegion(damage)
+	st = s.Status()
+	assert.NotEqual(t, status.InvalidStatus, st, "Status after MarkDirtyRegion on closed surface should be valid")
+}
+
+// TestBaseSurfaceGetFontOptions verifies a surface reports valid font
+// options, and NullPointer once closed.
+func TestBaseSurfaceGetFontOptions(t *testing.T) {
+	s := createTestSurface(t)
+
+	opts, err := s.GetFontOptions()
+	require.NoError(t, err)
+	defer func() {
+		_ = opts.Close()
+	}()
+	assert.Equal(t, status.Success, opts.Status())
+
+	require.NoError(t, s.Close())
+	_, err = s.GetFontOptions()
+	assert.Equal(t, status.NullPointer, err)
+}
+
+// TestBaseSurfaceHasShowTextGlyphs verifies image surfaces do not use text
+// clusters, and a closed surface reports false.
+func TestBaseSurfaceHasShowTextGlyphs(t *testing.T) {
+	s := createTestSurface(t)
+	assert.False(t, s.HasShowTextGlyphs())
+
+	require.NoError(t, s.Close())
+	assert.False(t, s.HasShowTextGlyphs())
 }
 
 // TestBaseSurfaceThreadSafety verifies concurrent access is safe
@@ -6,6 +6,7 @@
 package surface
 
 import (
+	"io"
 	"sync"
 
 	"github.com/mikowitz/cairo/status"
@@ -77,10 +78,12 @@ const (
 // Dimensions are specified in points, where 1 point equals 1/72 of an inch.
 // The coordinate origin is at the top-left corner of the image.
 //
-// Use NewSVGSurface to create an SVG surface. Close the surface when finished
-// to flush and finalize the SVG file.
+// Use NewSVGSurface to create an SVG surface, or NewSVGSurfaceForWriter to
+// write the document to an io.Writer. Close the surface when finished to flush
+// and finalize the SVG file.
 type SVGSurface struct {
 	*BaseSurface
+	stream *streamWriter
 }
 
 // NewSVGSurface creates a new SVG surface writing to filename.
@@ -96,6 +99,36 @@ func NewSVGSurface(filename string, widthPt, heightPt float64) (*SVGSurface, err
 	return &SVGSurface{BaseSurface: newBaseSurface(ptr)}, nil
 }
 
+// NewSVGSurfaceForWriter creates a new SVG surface writing the document to w,
+// such as an http.ResponseWriter or a bytes.Buffer.
+// widthPt and heightPt set the dimensions in points (1/72 inch).
+//
+// The document is written to w when the surface is closed. Close any contexts
+// drawing on the surface before closing it, since Close finishes the
+// document. Close returns status.WriteError if any write to w failed.
+//
+// Returns status.NullPointer if w is nil, or the Cairo error if the surface
+// cannot be created.
+func NewSVGSurfaceForWriter(w io.Writer, widthPt, heightPt float64) (*SVGSurface, error) {
+	if w == nil {
+		return nil, status.NullPointer
+	}
+
+	stream := newStreamWriter(w)
+	ptr, st := svgSurfaceCreateForStream(stream, widthPt, heightPt)
+	if st != status.Success {
+		return nil, st
+	}
+	return &SVGSurface{BaseSurface: newBaseSurface(ptr), stream: stream}, nil
+}
+
+// Close closes the surface and finalizes the SVG document. For surfaces
+// created with NewSVGSurfaceForWriter, the document is written before Close
+// returns, and status.WriteError is returned if any write failed.
+func (s *SVGSurface) Close() error {
+	return s.closeStream(s.stream)
+}
+
 // RestrictToVersion restricts the generated SVG output to the given version.
 // Must be called before any drawing operations; it has no effect on already-emitted output.
 // Use SVGVersions to query which versions are available.
@@ -1,5 +1,5 @@
 // ABOUTME: CGO bindings for Cairo SVG surface creation, document unit, and version configuration.
-// ABOUTME: Wraps cairo_svg_surface_create, cairo_svg_surface_set_document_unit, and version APIs.
+// ABOUTME: Wraps cairo_svg_surface_create and its stream variant, document unit, and version APIs.
 
 //go:build !nosvg
 
@@ -7,8 +7,15 @@ package surface
 
 // #cgo pkg-config: cairo-svg
 // #include <cairo-svg.h>
+// #include <stdint.h>
 // #include <stdlib.h>
 //
+// extern cairo_status_t goSurfaceWriteStream(void *closure, unsigned char *data, unsigned int length);
+//
+// static cairo_surface_t *_svgSurfaceCreateForStream(uintptr_t h, double width, double height) {
+//     return cairo_svg_surface_create_for_stream((cairo_write_func_t)goSurfaceWriteStream, (void *)h, width, height);
+// }
+//
 // // _svgGetVersionsList fills buf with supported SVG version identifiers and
 // // returns the count. buf must have room for at least 16 entries.
 // static int _svgGetVersionsList(cairo_svg_version_t *buf) {
@@ -19,7 +26,11 @@ package surface
 //     return n;
 // }
 import "C"
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/mikowitz/cairo/status"
+)
 
 func svgSurfaceCreate(filename string, widthPt, heightPt float64) SurfacePtr {
 	cFilename := C.CString(filename)
@@ -27,6 +38,12 @@ func svgSurfaceCreate(filename string, widthPt, heightPt float64) SurfacePtr {
 	return SurfacePtr(C.cairo_svg_surface_create(cFilename, C.double(widthPt), C.double(heightPt)))
 }
 
+func svgSurfaceCreateForStream(w *streamWriter, widthPt, heightPt float64) (SurfacePtr, status.Status) {
+	return surfaceCreateForStream(w, func(h C.uintptr_t) SurfacePtr {
+		return SurfacePtr(C._svgSurfaceCreateForStream(h, C.double(widthPt), C.double(heightPt)))
+	})
+}
+
 func svgSurfaceSetDocumentUnit(ptr SurfacePtr, unit SVGUnit) {
 	C.cairo_svg_surface_set_document_unit(ptr, C.cairo_svg_unit_t(unit))
 }
@@ -6,11 +6,13 @@
 package surface
 
 import (
+	"bytes"
 	"encoding/xml"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/mikowitz/cairo/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -123,3 +125,35 @@ func TestSVGSurfaceDocumentUnit(t *testing.T) {
 	// SetDocumentUnit on a closed surface should be a no-op, not a panic.
 	s.SetDocumentUnit(SVGUnitPx)
 }
+
+// TestNewSVGSurfaceForWriter verifies an SVG document is written to an
+// io.Writer by the time Close returns.
+func TestNewSVGSurfaceForWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	s, err := NewSVGSurfaceForWriter(&buf, 400, 300)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	s.SetDocumentUnit(SVGUnitPx)
+
+	require.NoError(t, s.Close())
+
+	assert.Contains(t, buf.String(), "<svg")
+	var v interface{}
+	assert.NoError(t, xml.Unmarshal(buf.Bytes(), &v), "SVG output should be valid XML")
+}
+
+// TestNewSVGSurfaceForWriterWriteError verifies writer failures are reported by Close.
+func TestNewSVGSurfaceForWriterWriteError(t *testing.T) {
+	s, err := NewSVGSurfaceForWriter(failingWriter{}, 400, 300)
+	require.NoError(t, err)
+
+	assert.Equal(t, status.WriteError, s.Close())
+}
+
+// TestNewSVGSurfaceForWriterNil verifies a nil writer is rejected.
+func TestNewSVGSurfaceForWriterNil(t *testing.T) {
+	s, err := NewSVGSurfaceForWriter(nil, 400, 300)
+	assert.Nil(t, s)
+	assert.Equal(t, status.NullPointer, err)
+}